@@ -0,0 +1,36 @@
+package clarifai
+
+import (
+	"net/http"
+	"strings"
+)
+
+// App is an application of a user.
+type App struct {
+	ID              string `json:"id"`
+	Name            string `json:"name,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+	DefaultLanguage string `json:"default_language,omitempty"`
+}
+
+// GetApps fetches a list of all applications of the user of a session authenticated by Personal Access Token.
+func (s *Session) GetApps() *Request {
+
+	r := NewRequest(s, http.MethodGet, "users/"+s.userID+"/apps")
+
+	if s.userID == "" {
+		r.err = ErrNoUserID
+	}
+
+	return r
+}
+
+// UseApp scopes subsequent calls of a session authenticated by Personal Access Token to an application appID.
+func (s *Session) UseApp(appID string) {
+	s.appID = appID
+}
+
+// isUserScoped checks if an endpoint is already scoped to a user, e.g. user applications.
+func isUserScoped(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "users/")
+}
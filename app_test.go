@@ -0,0 +1,50 @@
+package clarifai
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSession_GetApps(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/users/foo/apps", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"apps":[{"id":"bar","name":"Bar","created_at":"2020-01-01T00:00:00Z","default_language":"en"}]}`)
+	})
+
+	app := NewSessionWithPAT("test_pat", "foo", "baz")
+	app.SetBaseURL(ts.URL)
+
+	resp, err := app.GetApps().Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := []*App{
+		{ID: "bar", Name: "Bar", CreatedAt: "2020-01-01T00:00:00Z", DefaultLanguage: "en"},
+	}
+
+	CompareStructs(t, expected, resp.Apps)
+}
+
+func TestSession_GetApps_NoUserID(t *testing.T) {
+
+	_, err := NewApp("test_api_key").GetApps().Do()
+	if err != ErrNoUserID {
+		t.Errorf("Actual: %v, expected: %v", err, ErrNoUserID)
+	}
+}
+
+func TestSession_UseApp(t *testing.T) {
+
+	app := NewSessionWithPAT("test_pat", "foo", "bar")
+	app.UseApp("baz")
+
+	actual := app.buildURI("inputs")
+	expected := apiHost + "/v2/users/foo/apps/baz/inputs"
+
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
@@ -2,6 +2,12 @@
 package clarifai
 
 const (
-	ClientVersion = "0.5.1"
-	InputLimit    = 128
+	// Version is the version of the client, sent in the default User-Agent header.
+	Version = "0.5.1"
+
+	// ClientVersion is the version of the client.
+	// Deprecated: use Version.
+	ClientVersion = Version
+
+	InputLimit = 128
 )
@@ -0,0 +1,55 @@
+// Package clarifaitest provides utilities for testing code which calls Clarifai API via the clarifai package.
+package clarifaitest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	clarifai "github.com/mpmlj/clarifai-client-go"
+)
+
+// NewMockSession starts an HTTP test server with a given handler and returns a session calling it.
+// The session is authenticated by an API key, so it makes no authentication calls.
+// Close the server when done.
+func NewMockSession(handler http.HandlerFunc) (*clarifai.Session, *httptest.Server) {
+
+	ts := httptest.NewServer(handler)
+
+	s := clarifai.NewApp("test_api_key")
+	s.SetBaseURL(ts.URL)
+
+	return s, ts
+}
+
+// AssertPayloadJSON fails a test if the JSON body of a request differs from the one of a golden file.
+// Bodies are compared as JSON values, so formatting and order of keys do not matter.
+func AssertPayloadJSON(t testing.TB, r *clarifai.Request, golden string) {
+
+	t.Helper()
+
+	actual, err := r.PayloadJSON()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Error reading a golden file: %v", err)
+	}
+
+	var a, e interface{}
+	if err := json.Unmarshal(actual, &a); err != nil {
+		t.Fatalf("Invalid request payload: %v", err)
+	}
+	if err := json.Unmarshal(expected, &e); err != nil {
+		t.Fatalf("Invalid golden file %s: %v", golden, err)
+	}
+
+	if !reflect.DeepEqual(a, e) {
+		t.Errorf("Actual: %s, expected: %s", actual, expected)
+	}
+}
@@ -0,0 +1,64 @@
+package clarifaitest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	clarifai "github.com/mpmlj/clarifai-client-go"
+)
+
+func TestNewMockSession(t *testing.T) {
+
+	var path string
+
+	sess, ts := NewMockSession(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"foo"}}`)
+	})
+	defer ts.Close()
+
+	resp, err := sess.GetInput("foo").Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if path != "/v2/inputs/foo" {
+		t.Errorf("Actual: %v, expected: %v", path, "/v2/inputs/foo")
+	}
+
+	if resp.Input.ID != "foo" {
+		t.Errorf("Actual: %v, expected: %v", resp.Input.ID, "foo")
+	}
+}
+
+func TestAssertPayloadJSON(t *testing.T) {
+
+	sess, ts := NewMockSession(nil)
+	defer ts.Close()
+
+	im := clarifai.NewImageFromURL("https://samples.clarifai.com/wedding.jpg")
+	im.Metadata = map[string]string{"event_type": "wedding"}
+
+	i := clarifai.InitInputs()
+	_ = i.AddInput(im, "wedding-1")
+
+	AssertPayloadJSON(t, sess.AddInputs(i), "testdata/add_input_with_metadata.json")
+}
+
+func ExampleNewMockSession() {
+
+	sess, ts := NewMockSession(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"concepts":[{"id":"dog","name":"dog"}]}`)
+	})
+	defer ts.Close()
+
+	resp, err := sess.GetConcepts().UnmarshalConcepts()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(resp.Concepts[0].Name)
+	// Output: dog
+}
@@ -0,0 +1,17 @@
+package clarifai
+
+import "net/http"
+
+// Cluster is a group of visually similar inputs of an application.
+type Cluster struct {
+	ID    string  `json:"id"`
+	Count int     `json:"count"` // Number of inputs in the cluster.
+	Score float64 `json:"score"`
+	Hits  []*Hit  `json:"hits,omitempty"` // Most similar inputs of the cluster.
+}
+
+// GetInputClusters fetches clusters of similar inputs, built by a given embedding model.
+func (s *Session) GetInputClusters(modelID string) *Request {
+
+	return NewRequest(s, http.MethodGet, "models/"+modelID+"/clusters")
+}
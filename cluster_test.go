@@ -0,0 +1,19 @@
+package clarifai
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSession_GetInputClusters(t *testing.T) {
+
+	r := sess.GetInputClusters("foo")
+
+	if r.method != http.MethodGet {
+		t.Errorf("Actual: %v, expected: %v", r.method, http.MethodGet)
+	}
+
+	if r.path != "models/foo/clusters" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "models/foo/clusters")
+	}
+}
@@ -0,0 +1,139 @@
+package clarifai
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Concept is a concept of an application, e.g. a label used to tag inputs and train models.
+type Concept struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name,omitempty"`
+	CreatedAt string         `json:"created_at,omitempty"`
+	Language  string         `json:"language,omitempty"`
+	AppID     *string        `json:"app_id,omitempty"`
+	Status    *ServiceStatus `json:"status,omitempty"` // Set per concept in responses of CreateConcepts.
+}
+
+// GetConcepts fetches a list of all concepts of an application.
+func (s *Session) GetConcepts() *Request {
+
+	return NewRequest(s, http.MethodGet, "concepts")
+}
+
+// GetConcept fetches a single concept by its ID.
+func (s *Session) GetConcept(id string) *Request {
+
+	return NewRequest(s, http.MethodGet, "concepts/"+id)
+}
+
+// CreateConcepts adds new concepts to an application. Only IDs and names of the concepts are sent.
+// In case of a partial failure the response status is not successful and every concept has its own status.
+func (s *Session) CreateConcepts(concepts []Concept) *Request {
+
+	p := struct {
+		Concepts []*Concept `json:"concepts"`
+	}{
+		Concepts: make([]*Concept, len(concepts)),
+	}
+
+	for n, c := range concepts {
+		p.Concepts[n] = &Concept{
+			ID:   c.ID,
+			Name: c.Name,
+		}
+	}
+
+	r := NewRequest(s, http.MethodPost, "concepts")
+	r.SetPayload(p)
+
+	return r
+}
+
+// conceptSearchRequest is a payload of a concept search.
+type conceptSearchRequest struct {
+	ConceptQuery struct {
+		Name     string `json:"name"`
+		Language string `json:"language,omitempty"`
+	} `json:"concept_query"`
+	Pagination *pagination `json:"pagination,omitempty"`
+}
+
+func (q *conceptSearchRequest) setPagination(p *pagination) {
+	q.Pagination = p
+}
+
+// SearchConcepts searches for concepts by a name prefix, optionally in a specific language (empty string for any).
+// A trailing wildcard "*" is added to the prefix automatically if absent.
+func (s *Session) SearchConcepts(prefix, language string) *Request {
+
+	r := NewRequest(s, http.MethodPost, "concepts/searches")
+
+	if language != "" {
+		if _, ok := SupportedLanguages[language]; !ok {
+			r.err = ErrUnsupportedLanguage
+			return r
+		}
+	}
+
+	if !strings.HasSuffix(prefix, "*") {
+		prefix += "*"
+	}
+
+	p := &conceptSearchRequest{}
+	p.ConceptQuery.Name = prefix
+	p.ConceptQuery.Language = language
+
+	r.SetPayload(p)
+
+	return r
+}
+
+// Predicates of concept relations.
+const (
+	PredicateHyponym  = "hyponym"  // The subject concept is a kind of the object concept.
+	PredicateHypernym = "hypernym" // The object concept is a kind of the subject concept.
+	PredicateSynonym  = "synonym"
+)
+
+// ConceptRelation is a relation between two concepts of a knowledge graph.
+type ConceptRelation struct {
+	ID             string   `json:"id,omitempty"`
+	SubjectConcept *Concept `json:"subject_concept,omitempty"`
+	ObjectConcept  *Concept `json:"object_concept,omitempty"`
+	Predicate      string   `json:"predicate"`
+}
+
+// GetConceptRelations fetches relations of a concept ("concept_relations").
+func (s *Session) GetConceptRelations(conceptID string) *Request {
+
+	return NewRequest(s, http.MethodGet, "concepts/"+conceptID+"/relations")
+}
+
+// AddConceptRelation adds a relation between two concepts, predicate is one of Predicate* constants.
+func (s *Session) AddConceptRelation(from, to, predicate string) *Request {
+
+	r := NewRequest(s, http.MethodPost, "concepts/"+from+"/relations")
+
+	switch predicate {
+	case PredicateHyponym, PredicateHypernym, PredicateSynonym:
+	default:
+		r.err = ErrInvalidPredicate
+		return r
+	}
+
+	p := struct {
+		ConceptRelations []*ConceptRelation `json:"concept_relations"`
+	}{
+		ConceptRelations: []*ConceptRelation{
+			{
+				ObjectConcept: &Concept{ID: to},
+				Predicate:     predicate,
+			},
+		},
+	}
+
+	r.SetPayload(p)
+
+	return r
+}
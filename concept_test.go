@@ -0,0 +1,185 @@
+package clarifai
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSession_GetConcepts(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "concepts", "resp/ok_10000_get_concepts.json")
+
+	resp, err := sess.GetConcepts().Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Concepts) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Concepts), 2)
+	}
+
+	appID := "f09abb8a57c041cbb94759ebb0cf1b0d"
+	expected := &Concept{
+		ID:        "dog",
+		Name:      "dog",
+		CreatedAt: "2017-01-12T18:22:55.900770Z",
+		Language:  "en",
+		AppID:     &appID,
+	}
+
+	CompareStructs(t, expected, resp.Concepts[1])
+}
+
+func TestSession_GetConcept(t *testing.T) {
+
+	r := sess.GetConcept("cat")
+
+	if r.method != http.MethodGet {
+		t.Errorf("Actual: %v, expected: %v", r.method, http.MethodGet)
+	}
+
+	if r.path != "concepts/cat" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "concepts/cat")
+	}
+}
+
+func TestSession_CreateConcepts(t *testing.T) {
+
+	r := sess.CreateConcepts([]Concept{
+		{ID: "cat", Name: "Cat", Language: "en"},
+		{ID: "dog"},
+	})
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"concepts":[{"id":"cat","name":"Cat"},{"id":"dog"}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_CreateConcepts_MixedSuccess(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "concepts", "resp/ok_10010_create_concepts_mixed_success.json")
+
+	resp, err := sess.CreateConcepts([]Concept{{ID: "cat"}, {ID: "dog"}}).UnmarshalConcepts()
+	if _, ok := err.(*APIError); !ok {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	if len(resp.Concepts) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Concepts), 2)
+	}
+
+	if resp.Concepts[0].Status.Code != 10000 {
+		t.Errorf("Actual: %v, expected: %v", resp.Concepts[0].Status.Code, 10000)
+	}
+
+	if resp.Concepts[1].Status.Code != 40003 {
+		t.Errorf("Actual: %v, expected: %v", resp.Concepts[1].Status.Code, 40003)
+	}
+}
+
+func TestSession_SearchConcepts(t *testing.T) {
+
+	r := sess.SearchConcepts("ca", "en")
+	r.addPagination()
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"concept_query":{"name":"ca*","language":"en"},"pagination":{"page":1,"per_page":20}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	r = sess.SearchConcepts("ca*", "")
+
+	actual, err = json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"concept_query":{"name":"ca*"}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_SearchConcepts_UnsupportedLanguage(t *testing.T) {
+
+	_, err := sess.SearchConcepts("ca", "xx").Do()
+	if err != ErrUnsupportedLanguage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrUnsupportedLanguage)
+	}
+}
+
+func TestSession_SearchConcepts_Response(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "concepts/searches", "resp/ok_10000_get_concepts.json")
+
+	resp, err := sess.SearchConcepts("ca", "").UnmarshalConcepts()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Concepts) != 2 {
+		t.Errorf("Actual: %v, expected: %v", len(resp.Concepts), 2)
+	}
+}
+
+func TestSession_GetConceptRelations(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "concepts/dog/relations", "resp/ok_10000_get_concept_relations.json")
+
+	resp, err := sess.GetConceptRelations("dog").Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.ConceptRelations) != 1 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.ConceptRelations), 1)
+	}
+
+	expected := &ConceptRelation{
+		ID:             "c7b4b2e0d1f64c4c9d1ab2a4f0c5e6a1",
+		SubjectConcept: &Concept{ID: "dog", Name: "dog"},
+		ObjectConcept:  &Concept{ID: "animal", Name: "animal"},
+		Predicate:      PredicateHyponym,
+	}
+
+	CompareStructs(t, expected, resp.ConceptRelations[0])
+}
+
+func TestSession_AddConceptRelation(t *testing.T) {
+
+	r := sess.AddConceptRelation("dog", "animal", PredicateHyponym)
+
+	if r.path != "concepts/dog/relations" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "concepts/dog/relations")
+	}
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"concept_relations":[{"object_concept":{"id":"animal"},"predicate":"hyponym"}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	if _, err = sess.AddConceptRelation("dog", "animal", "foo").Do(); err != ErrInvalidPredicate {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidPredicate)
+	}
+}
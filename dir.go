@@ -0,0 +1,66 @@
+package clarifai
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// InputsFromDir builds image inputs from files of a directory and its subdirectories, which base names match a pattern
+// as per filepath.Match. An empty pattern matches all files. Files of unsupported types are skipped.
+// Inputs are split into chunks of at most InputLimit items, so each of them can be sent by AddInputs.
+// Files which fail to be read are returned in a MultiError along with inputs of the rest of files.
+func InputsFromDir(dir, pattern string) ([]*Inputs, error) {
+
+	var chunks []*Inputs
+	var errs MultiError
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			errs = append(errs, fmt.Errorf("File %s: %w", path, err))
+			return nil
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		if pattern != "" {
+			ok, err := filepath.Match(pattern, info.Name())
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+		}
+
+		im, err := NewImageFromFile(path)
+		if errors.Is(err, ErrUnsupportedMimeType) {
+			return nil
+		}
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+
+		if len(chunks) == 0 || len(chunks[len(chunks)-1].Inputs) == InputLimit {
+			chunks = append(chunks, InitInputs())
+		}
+
+		return chunks[len(chunks)-1].AddInput(im, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(errs) > 0 {
+		return chunks, errs
+	}
+
+	return chunks, nil
+}
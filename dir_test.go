@@ -0,0 +1,131 @@
+package clarifai
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// newImageDir creates a temporary directory with n copies of a test image in a subdirectory and a few files of other types.
+// The directory should be removed by the caller.
+func newImageDir(t *testing.T, n int) string {
+
+	img, err := ioutil.ReadFile("mocks/test_image.jpg")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	gif, err := ioutil.ReadFile("mocks/unsupported_mime_type_gif.gif")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "clarifai")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0755); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	for j := 0; j < n; j++ {
+		name := filepath.Join(dir, "sub", "image-"+strconv.Itoa(j)+".jpg")
+		if err := ioutil.WriteFile(name, img, 0644); err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+	}
+
+	_ = ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not an image"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "animation.gif"), gif, 0644)
+
+	return dir
+}
+
+func TestInputsFromDir(t *testing.T) {
+
+	dir := newImageDir(t, InputLimit+2)
+	defer os.RemoveAll(dir)
+
+	chunks, err := InputsFromDir(dir, "")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(chunks) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(chunks), 2)
+	}
+
+	if len(chunks[0].Inputs) != InputLimit || len(chunks[1].Inputs) != 2 {
+		t.Errorf("Actual: %v and %v, expected: %v and %v", len(chunks[0].Inputs), len(chunks[1].Inputs), InputLimit, 2)
+	}
+
+	if chunks[0].Inputs[0].Data.Properties.Base64 == "" {
+		t.Error("Inputs should have base64 encoded images")
+	}
+}
+
+func TestInputsFromDir_Pattern(t *testing.T) {
+
+	dir := newImageDir(t, 3)
+	defer os.RemoveAll(dir)
+
+	chunks, err := InputsFromDir(dir, "image-[01].jpg")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(chunks) != 1 || len(chunks[0].Inputs) != 2 {
+		t.Errorf("Actual: %+v, expected %v inputs", chunks, 2)
+	}
+
+	if _, err := InputsFromDir(dir, "["); err != filepath.ErrBadPattern {
+		t.Errorf("Actual: %v, expected: %v", err, filepath.ErrBadPattern)
+	}
+}
+
+func TestInputsFromDir_FileErrors(t *testing.T) {
+
+	dir := newImageDir(t, 2)
+	defer os.RemoveAll(dir)
+
+	// A dangling link fails to be read, but the rest of files are still added.
+	if err := os.Symlink(filepath.Join(dir, "missing.jpg"), filepath.Join(dir, "broken.jpg")); err != nil {
+		t.Skipf("Symbolic links are not supported: %v", err)
+	}
+
+	chunks, err := InputsFromDir(dir, "*.jpg")
+
+	errs, ok := err.(MultiError)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Actual: %v, expected a MultiError of 1 error", err)
+	}
+
+	if !errors.Is(errs[0], os.ErrNotExist) {
+		t.Errorf("Actual: %v, expected: %v", errs[0], os.ErrNotExist)
+	}
+
+	if len(chunks) != 1 || len(chunks[0].Inputs) != 2 {
+		t.Errorf("Actual: %+v, expected %v inputs", chunks, 2)
+	}
+}
+
+func TestInputsFromDir_NoDir(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "clarifai")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	chunks, err := InputsFromDir(filepath.Join(dir, "missing"), "")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Actual: %v, expected: %v", err, os.ErrNotExist)
+	}
+
+	if chunks != nil {
+		t.Errorf("Actual: %v, expected: %v", chunks, nil)
+	}
+}
@@ -1,9 +1,92 @@
 package clarifai
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 var (
 	ErrNoAuthenticationToken = errors.New("No authentication token returned!")
+	ErrResponseTooLarge      = errors.New("Response body exceeds the maximum size!")
+	ErrSessionClosed         = errors.New("Session is closed!")
+	ErrNoUserID              = errors.New("User ID is required, use a session with a Personal Access Token!")
 	ErrInputLimitReached     = errors.New("Reached maximum number of allowed inputs!")
 	ErrUnsupportedMimeType   = errors.New("Image input with an unsupported mime type provided!")
+	ErrUnsupportedLanguage   = errors.New("Unsupported language provided!")
+	ErrInvalidPredicate      = errors.New("Unsupported concept relation predicate provided!")
+	ErrInvalidMaxConcepts    = errors.New("Max concepts must be positive!")
+	ErrInvalidMinValue       = errors.New("Min value must be between 0 and 1!")
+	ErrInvalidMinScore       = errors.New("Min score must be between 0 and 1!")
+	ErrInvalidConceptRange   = errors.New("Concept range min should not be greater than max!")
+	ErrInvalidCrop           = errors.New("Crop values should be between 0 and 1 with top < bottom and left < right!")
+	ErrNoBoundingBox         = errors.New("Region should have a bounding box!")
+	ErrImageTooLarge         = errors.New("Image exceeds the maximum size!")
+	ErrInvalidInputLimit     = errors.New("Input limit must be positive!")
+	ErrNilInput              = errors.New("Nil input provided!")
+	ErrConflictingModels     = errors.New("Inputs to merge should have the same model settings!")
+	ErrInvalidInputID        = errors.New("Input ID should be up to 255 letters, digits, dashes or underscores!")
+	ErrEmptyImageData        = errors.New("Image, video or text input should have a URL or base64 (raw) data!")
+	ErrStreamedImage         = errors.New("Streamed image can only be sent by AddInputsStreamed!")
+	ErrAmbiguousImageData    = errors.New("Image, video or text input should have either a URL or base64 (raw) data, not both!")
+	ErrInvalidLongitude      = errors.New("Longitude should be between -180 and 180!")
+	ErrInvalidLatitude       = errors.New("Latitude should be between -90 and 90!")
+	ErrInvalidGeoLimitType   = errors.New("Unsupported geo limit type provided!")
+	ErrInvalidGeoRadius      = errors.New("Geo search radius should be greater than 0!")
+	ErrNoModelVersionStatus  = errors.New("No model version status returned!")
+	ErrNoInputStatus         = errors.New("No input status returned!")
+	ErrModelTrainingFailed   = errors.New("Model training failed!")
+	ErrInvalidPage           = errors.New("Page number should be 1 or greater!")
+	ErrInvalidPerPage        = errors.New("Number of items per page should be between 1 and 1000!")
 )
+
+// APIError is returned when Clarifai API responds with a non-2xx HTTP status
+// or with a status code other than success in the response body.
+// HTTPStatus is 0 for errors of statuses nested in a response, see ServiceStatus.Error.
+type APIError struct {
+	HTTPStatus  int
+	Code        StatusCode
+	Description string
+	Details     string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("Clarifai API error %d (%s)", e.Code, e.Description)
+	if e.Details != "" {
+		msg += ": " + e.Details
+	}
+	if e.HTTPStatus != 0 {
+		msg += fmt.Sprintf(", HTTP status %d", e.HTTPStatus)
+	}
+	return msg
+}
+
+// newAPIError builds an API error from an HTTP status and the response status envelope, if any.
+func newAPIError(httpStatus int, resp *Response) *APIError {
+
+	e := &APIError{
+		HTTPStatus: httpStatus,
+	}
+
+	if resp != nil && resp.Status != nil && resp.Status.Code != 0 {
+		e.Code = resp.Status.Code
+		e.Description = resp.Status.Description
+		e.Details = resp.Status.Details
+	} else {
+		e.Description = http.StatusText(httpStatus)
+	}
+
+	return e
+}
+
+// MultiError is a collection of errors returned by batch operations.
+type MultiError []error
+
+func (e MultiError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,56 @@
+package clarifai
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_StatusCode(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/fail_11100_bad_req_supply_inputs.json")
+
+	resp, err := sess.Predict(InitInputs()).Do()
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	expected := &APIError{
+		HTTPStatus:  http.StatusOK,
+		Code:        11100,
+		Description: "Bad request format",
+		Details:     "Must supply the 'inputs' field. Check the JSON body of your request.",
+	}
+	CompareStructs(t, expected, apiErr)
+
+	if resp == nil || resp.Status == nil {
+		t.Error("Response should be returned along with the error")
+	}
+}
+
+func TestAPIError_HTTPStatus(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/status", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		printMock(t, w, "resp/404.json")
+	})
+
+	_, err := sess.GetInputStatuses().Do()
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	if apiErr.HTTPStatus != http.StatusNotFound {
+		t.Errorf("Actual: %v, expected: %v", apiErr.HTTPStatus, http.StatusNotFound)
+	}
+
+	if apiErr.Description != http.StatusText(http.StatusNotFound) {
+		t.Errorf("Actual: %v, expected: %v", apiErr.Description, http.StatusText(http.StatusNotFound))
+	}
+}
@@ -0,0 +1,100 @@
+package clarifai
+
+import "net/http"
+
+// ConceptFeedback is a correction of a predicted concept, Value tells if the concept is present in the input.
+type ConceptFeedback struct {
+	ID    string `json:"id"`
+	Value bool   `json:"value"`
+}
+
+// feedbackInfo describes a prediction being corrected.
+type feedbackInfo struct {
+	EventType string `json:"event_type"`
+	OutputID  string `json:"output_id"`
+}
+
+// feedbackRequest is a payload of a predict feedback call.
+type feedbackRequest struct {
+	Input struct {
+		ID   string `json:"id"`
+		Data struct {
+			Concepts []ConceptFeedback `json:"concepts"`
+		} `json:"data"`
+		FeedbackInfo feedbackInfo `json:"feedback_info"`
+	} `json:"input"`
+}
+
+// feedbackRegion is a corrected region of a region feedback call.
+type feedbackRegion struct {
+	ID         string      `json:"id,omitempty"`
+	RegionInfo *RegionInfo `json:"region_info"`
+	Data       struct {
+		Concepts []ConceptFeedback `json:"concepts"`
+	} `json:"data"`
+}
+
+// regionFeedbackRequest is a payload of a region feedback call.
+type regionFeedbackRequest struct {
+	Input struct {
+		ID   string `json:"id"`
+		Data struct {
+			Regions []*feedbackRegion `json:"regions"`
+		} `json:"data"`
+		FeedbackInfo feedbackInfo `json:"feedback_info"`
+	} `json:"input"`
+}
+
+// SendPredictFeedback sends corrections of concepts predicted by a model for an input.
+// outputID is an ID of the predict output being corrected.
+func (s *Session) SendPredictFeedback(modelID, inputID, outputID string, corrections []ConceptFeedback) *Request {
+
+	p := &feedbackRequest{}
+	p.Input.ID = inputID
+	p.Input.Data.Concepts = corrections
+	p.Input.FeedbackInfo.EventType = "annotation"
+	p.Input.FeedbackInfo.OutputID = outputID
+
+	if p.Input.Data.Concepts == nil {
+		p.Input.Data.Concepts = make([]ConceptFeedback, 0)
+	}
+
+	r := NewRequest(s, http.MethodPost, "models/"+modelID+"/feedback")
+	r.SetPayload(p)
+
+	return r
+}
+
+// SendRegionFeedback sends corrections of concepts predicted by a detection model for a region of an input.
+// The region should have a bounding box, e.g. a region of a predict output.
+func (s *Session) SendRegionFeedback(modelID, inputID, outputID string, region Region, corrections []ConceptFeedback) *Request {
+
+	r := NewRequest(s, http.MethodPost, "models/"+modelID+"/feedback")
+
+	if region.RegionInfo == nil || region.RegionInfo.BoundingBox == nil {
+		r.err = ErrNoBoundingBox
+		return r
+	}
+
+	fr := &feedbackRegion{
+		ID: region.ID,
+		RegionInfo: &RegionInfo{
+			BoundingBox: region.RegionInfo.BoundingBox,
+		},
+	}
+	fr.Data.Concepts = corrections
+
+	if fr.Data.Concepts == nil {
+		fr.Data.Concepts = make([]ConceptFeedback, 0)
+	}
+
+	p := &regionFeedbackRequest{}
+	p.Input.ID = inputID
+	p.Input.Data.Regions = []*feedbackRegion{fr}
+	p.Input.FeedbackInfo.EventType = "annotation"
+	p.Input.FeedbackInfo.OutputID = outputID
+
+	r.SetPayload(p)
+
+	return r
+}
@@ -0,0 +1,75 @@
+package clarifai
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSession_SendPredictFeedback(t *testing.T) {
+
+	r := sess.SendPredictFeedback(PublicModelGeneral, "foo", "bar", []ConceptFeedback{
+		{ID: "dog", Value: true},
+		{ID: "cat", Value: false},
+	})
+
+	if r.path != "models/"+PublicModelGeneral+"/feedback" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "models/"+PublicModelGeneral+"/feedback")
+	}
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"input":{"id":"foo","data":{"concepts":[{"id":"dog","value":true},{"id":"cat","value":false}]},"feedback_info":{"event_type":"annotation","output_id":"bar"}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_SendRegionFeedback(t *testing.T) {
+
+	region := Region{
+		ID: "baz",
+		RegionInfo: &RegionInfo{
+			BoundingBox: &BoundingBox{
+				TopRow:    0.1,
+				LeftCol:   0.2,
+				BottomRow: 0.3,
+				RightCol:  0.4,
+			},
+		},
+		Data: &RegionData{
+			Concepts: []*OutputConcept{{ID: "cat", Value: 0.9}},
+		},
+	}
+
+	r := sess.SendRegionFeedback(PublicModelFaceDetection, "foo", "bar", region, []ConceptFeedback{
+		{ID: "face", Value: true},
+	})
+
+	if r.path != "models/"+PublicModelFaceDetection+"/feedback" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "models/"+PublicModelFaceDetection+"/feedback")
+	}
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"input":{"id":"foo","data":{"regions":[{"id":"baz",` +
+		`"region_info":{"bounding_box":{"top_row":0.1,"left_col":0.2,"bottom_row":0.3,"right_col":0.4}},` +
+		`"data":{"concepts":[{"id":"face","value":true}]}}]},` +
+		`"feedback_info":{"event_type":"annotation","output_id":"bar"}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_SendRegionFeedback_NoBoundingBox(t *testing.T) {
+
+	_, err := sess.SendRegionFeedback(PublicModelFaceDetection, "foo", "bar", Region{ID: "baz"}, nil).Do()
+	if err != ErrNoBoundingBox {
+		t.Errorf("Actual: %v, expected: %v", err, ErrNoBoundingBox)
+	}
+}
@@ -0,0 +1,63 @@
+package clarifai
+
+const (
+	// Units of a geo search radius.
+	GeoLimitWithinMiles      = "withinMiles"
+	GeoLimitWithinKilometers = "withinKilometers"
+	GeoLimitWithinDegrees    = "withinDegrees"
+	GeoLimitWithinRadians    = "withinRadians"
+)
+
+// Geo is a geographical data of an input.
+type Geo struct {
+	GeoPoint *GeoPoint `json:"geo_point,omitempty"`
+	GeoLimit *GeoLimit `json:"geo_limit,omitempty"` // used in geo search
+}
+
+// GeoPoint is a location defined by longitude and latitude in degrees.
+type GeoPoint struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+}
+
+// GeoLimit is a search radius around a geo point.
+type GeoLimit struct {
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+}
+
+// newGeoLimit validates a search radius and returns a new geo limit.
+func newGeoLimit(radius float64, unit string) (*GeoLimit, error) {
+
+	switch unit {
+	case GeoLimitWithinMiles, GeoLimitWithinKilometers, GeoLimitWithinDegrees, GeoLimitWithinRadians:
+	default:
+		return nil, ErrInvalidGeoLimitType
+	}
+
+	if radius <= 0 {
+		return nil, ErrInvalidGeoRadius
+	}
+
+	return &GeoLimit{
+		Type:  unit,
+		Value: radius,
+	}, nil
+}
+
+// newGeoPoint validates coordinates and returns a new geo point.
+func newGeoPoint(longitude, latitude float64) (*GeoPoint, error) {
+
+	if longitude < -180 || longitude > 180 {
+		return nil, ErrInvalidLongitude
+	}
+
+	if latitude < -90 || latitude > 90 {
+		return nil, ErrInvalidLatitude
+	}
+
+	return &GeoPoint{
+		Longitude: longitude,
+		Latitude:  latitude,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package clarifai
+
+import (
+	"testing"
+)
+
+func TestNewGeoPoint(t *testing.T) {
+
+	tests := []struct {
+		longitude float64
+		latitude  float64
+		expected  error
+	}{
+		{-180, -90, nil},
+		{180, 90, nil},
+		{-180.1, 0, ErrInvalidLongitude},
+		{180.1, 0, ErrInvalidLongitude},
+		{0, -90.1, ErrInvalidLatitude},
+		{0, 90.1, ErrInvalidLatitude},
+	}
+
+	for _, tt := range tests {
+		_, err := newGeoPoint(tt.longitude, tt.latitude)
+		if err != tt.expected {
+			t.Errorf("Longitude %v, latitude %v | Actual: %v, expected: %v", tt.longitude, tt.latitude, err, tt.expected)
+		}
+	}
+}
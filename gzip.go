@@ -0,0 +1,55 @@
+package clarifai
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// SetRequestCompression enables gzip compression of request bodies of at least minBytes bytes.
+// Compression is disabled by default and when minBytes is 0. Streamed request bodies are never compressed.
+// Compressed responses are always accepted and decompressed transparently.
+func (s *Session) SetRequestCompression(minBytes int) {
+	s.gzipMinBytes = minBytes
+}
+
+// compressBody gzips a request body.
+func compressBody(r io.Reader) (*bytes.Reader, error) {
+
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	if _, err := io.Copy(w, r); err != nil {
+		return nil, fmt.Errorf("Unable to compress request: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("Unable to compress request: %w", err)
+	}
+
+	return bytes.NewReader(buf.Bytes()), nil
+}
+
+// decompressBody reads a gzipped response body.
+func decompressBody(r io.Reader) (io.Reader, error) {
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to decompress response: %w", err)
+	}
+
+	return &gzipBody{zr}, nil
+}
+
+// gzipBody wraps read errors of a gzipped response body.
+type gzipBody struct {
+	zr *gzip.Reader
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	n, err := b.zr.Read(p)
+	if err != nil && err != io.EOF {
+		err = fmt.Errorf("Unable to decompress response: %w", err)
+	}
+	return n, err
+}
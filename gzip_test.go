@@ -0,0 +1,90 @@
+package clarifai
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSession_SetRequestCompression(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/gzip", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "gzip" {
+			t.Errorf("Actual: %v, expected: %v", r.Header.Get("Content-Encoding"), "gzip")
+		}
+
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+		body, _ := ioutil.ReadAll(zr)
+
+		if !strings.Contains(string(body), "metro-north.jpg") {
+			t.Errorf("Actual: %s, expected a body with inputs", body)
+		}
+
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetRequestCompression(10)
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	if _, err := app.HTTPCall(http.MethodPost, "inputs/gzip", i); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+}
+
+func TestSession_GzipResponse(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/gzip", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Actual: %v, expected: %v", r.Header.Get("Accept-Encoding"), "gzip")
+		}
+
+		if r.Header.Get("Content-Encoding") != "" {
+			t.Errorf("Request body should not be compressed by default")
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		_, _ = zw.Write([]byte(`{"status":{"code":10000,"description":"Ok"},"input":{"id":"foo"}}`))
+		_ = zw.Close()
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+
+	resp, err := app.HTTPCall(http.MethodGet, "inputs/gzip", nil)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if resp.Input.ID != "foo" {
+		t.Errorf("Actual: %v, expected: %v", resp.Input.ID, "foo")
+	}
+}
+
+func TestSession_GzipResponse_Invalid(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/gzip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write([]byte("not gzip"))
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+
+	_, err := app.HTTPCall(http.MethodGet, "inputs/gzip", nil)
+	if err == nil || !strings.HasPrefix(err.Error(), "Unable to decompress response") {
+		t.Errorf("Actual: %v, expected a decompression error", err)
+	}
+}
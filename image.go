@@ -2,6 +2,8 @@ package clarifai
 
 import (
 	"encoding/base64"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -10,13 +12,17 @@ import (
 type Image struct {
 	Concepts   []map[string]interface{} `json:"concepts,omitempty"`
 	Metadata   interface{}              `json:"metadata,omitempty"`
-	Properties *ImageProperties         `json:"image, omitempty"`
+	Properties *ImageProperties         `json:"image,omitempty"`
+	Geo        *Geo                     `json:"geo,omitempty"`
+	Video      *Video                   `json:"video,omitempty"`
+	Text       *Text                    `json:"text,omitempty"`
+	file       string                   // Local file of a streamed image, see NewImageFromFileStreamed.
 }
 
 type ImageData struct {
 	Concepts   []map[string]interface{} `json:"concepts,omitempty"`
 	Metadata   interface{}              `json:"metadata,omitempty"`
-	Properties *ImageProperties         `json:"image, omitempty"`
+	Properties *ImageProperties         `json:"image,omitempty"`
 }
 
 type ImageProperties struct {
@@ -49,7 +55,7 @@ func NewImageFromURL(url string) *Image {
 	}
 }
 
-// NewImageFromURL instantiates a new image from a local file.
+// NewImageFromFile instantiates a new image from a local file.
 func NewImageFromFile(path string) (*Image, error) {
 
 	base64Str, err := addFromBase64(path)
@@ -64,12 +70,82 @@ func NewImageFromFile(path string) (*Image, error) {
 	}, nil
 }
 
+// NewImageFromBytes instantiates a new image from raw image bytes.
+func NewImageFromBytes(b []byte) *Image {
+
+	return &Image{
+		Properties: &ImageProperties{
+			Base64: base64.StdEncoding.EncodeToString(b),
+		},
+	}
+}
+
+// NewImageFromReader instantiates a new image from a reader, e.g. an uploaded file.
+// The whole stream is read, use NewImageFromReaderWithLimit to limit its size.
+func NewImageFromReader(r io.Reader) (*Image, error) {
+	return NewImageFromReaderWithLimit(r, 0)
+}
+
+// NewImageFromReaderWithLimit instantiates a new image from a reader, reading up to maxBytes bytes.
+// A larger image fails with ErrImageTooLarge. The size is unlimited if maxBytes is 0.
+func NewImageFromReaderWithLimit(r io.Reader, maxBytes int64) (*Image, error) {
+
+	if maxBytes > 0 {
+		r = io.LimitReader(r, maxBytes+1)
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return &Image{}, fmt.Errorf("Unable to read image: %w", err)
+	}
+
+	if maxBytes > 0 && int64(len(data)) > maxBytes {
+		return &Image{}, ErrImageTooLarge
+	}
+
+	if err := validateLocalFile(data); err != nil {
+		return &Image{}, err
+	}
+
+	return NewImageFromBytes(data), nil
+}
+
 // AllowDuplicates enables image duplicates.
 func (i *Image) AllowDuplicates() {
+	i.AllowDuplicateURL(true)
+}
+
+// AllowDuplicateURL sets whether the API should accept an image URL that already exists in the application.
+// The flag is only sent to the API when enabled.
+func (i *Image) AllowDuplicateURL(b bool) {
 	if i.Properties == nil {
 		i.Properties = &ImageProperties{}
 	}
-	i.Properties.AllowDuplicateURL = true
+	i.Properties.AllowDuplicateURL = b
+}
+
+// validate checks that an image, a video or a text of the input data has exactly one of URL and base64 (raw text) set.
+func (i *Image) validate() error {
+
+	var url, base64 string
+	switch {
+	case i.Properties != nil:
+		url, base64 = i.Properties.URL, i.Properties.Base64
+	case i.Video != nil:
+		url, base64 = i.Video.URL, i.Video.Base64
+	case i.Text != nil:
+		url, base64 = i.Text.URL, i.Text.Raw
+	}
+
+	if url == "" && base64 == "" && i.file == "" {
+		return ErrEmptyImageData
+	}
+
+	if url != "" && base64 != "" {
+		return ErrAmbiguousImageData
+	}
+
+	return nil
 }
 
 // AddMetadata adds an image metadata.
@@ -89,6 +165,28 @@ func (i *Image) AddCrop(args ...float32) {
 	}
 }
 
+// SetCrop sets an image region to use, as fractions of the image height (top, bottom) and width (left, right).
+// Values should be between 0 and 1, top should be less than bottom and left should be less than right.
+func (i *Image) SetCrop(top, left, bottom, right float64) error {
+
+	for _, v := range []float64{top, left, bottom, right} {
+		if v < 0 || v > 1 {
+			return ErrInvalidCrop
+		}
+	}
+
+	if top >= bottom || left >= right {
+		return ErrInvalidCrop
+	}
+
+	if i.Properties == nil {
+		i.Properties = &ImageProperties{}
+	}
+	i.Properties.Crop = []float32{float32(top), float32(left), float32(bottom), float32(right)}
+
+	return nil
+}
+
 // AddConcept adds an image concept.
 func (i *Image) AddConcept(id string, value interface{}) {
 
@@ -110,13 +208,13 @@ func addFromBase64(filename string) (string, error) {
 
 	file, err := os.Open(filename)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("Unable to open image file %s: %w", filename, err)
 	}
 	defer file.Close()
 
 	data, err := ioutil.ReadAll(file)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("Unable to read image file %s: %w", filename, err)
 	}
 
 	valErr := validateLocalFile(data)
@@ -1,7 +1,13 @@
 package clarifai
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -29,6 +35,108 @@ func TestImageInputFromPath(t *testing.T) {
 	}
 }
 
+func TestImageInputFromPath_NotFound(t *testing.T) {
+
+	_, err := NewImageFromFile("mocks/no_such_image.jpg")
+	if err == nil {
+		t.Fatal("Should have an error for a missing file")
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Actual: %v, expected an error wrapping %v", err, os.ErrNotExist)
+	}
+}
+
+func TestImageInputFromBytes(t *testing.T) {
+
+	b, err := ioutil.ReadFile("mocks/test_image.jpg")
+	if err != nil {
+		t.Fatalf("Error reading a mock file: %v", err)
+	}
+
+	i := NewImageFromBytes(b)
+
+	if i.Properties.Base64 != TestImageBase64 {
+		t.Errorf("Actual: %v, expected: %v", i.Properties.Base64, TestImageBase64)
+	}
+
+	if i.Properties.URL != "" {
+		t.Errorf("Actual: %v, expected: %v", i.Properties.URL, "")
+	}
+}
+
+func TestImageInputFromReader(t *testing.T) {
+
+	b, err := ioutil.ReadFile("mocks/test_image.jpg")
+	if err != nil {
+		t.Fatalf("Error reading a mock file: %v", err)
+	}
+
+	i, err := NewImageFromReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if i.Properties.Base64 != TestImageBase64 {
+		t.Errorf("Actual: %v, expected: %v", i.Properties.Base64, TestImageBase64)
+	}
+
+	if _, err := NewImageFromReaderWithLimit(bytes.NewReader(b), int64(len(b))); err != nil {
+		t.Errorf("Should have no errors, but got %v", err)
+	}
+
+	if _, err := NewImageFromReaderWithLimit(bytes.NewReader(b), int64(len(b)-1)); err != ErrImageTooLarge {
+		t.Errorf("Actual: %v, expected: %v", err, ErrImageTooLarge)
+	}
+
+	if _, err := NewImageFromReader(strings.NewReader("not an image")); err != ErrUnsupportedMimeType {
+		t.Errorf("Actual: %v, expected: %v", err, ErrUnsupportedMimeType)
+	}
+}
+
+func TestImage_MarshalURL(t *testing.T) {
+
+	i := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+
+	actual, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestImage_AllowDuplicateURL(t *testing.T) {
+
+	i := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+	i.AllowDuplicateURL(true)
+
+	actual, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"image":{"allow_duplicate_url":true,"url":"https://samples.clarifai.com/metro-north.jpg"}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	i.AllowDuplicateURL(false)
+
+	actual, err = json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
 func TestValidateLocalFile_Fail(t *testing.T) {
 	path := "mocks/unsupported_mime_type_gif.gif"
 	expected := ErrUnsupportedMimeType
@@ -53,6 +161,50 @@ func TestImage_AddCrop(t *testing.T) {
 	}
 }
 
+func TestImage_SetCrop(t *testing.T) {
+
+	i := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+	i.AddCrop(0.1, 0.1, 0.9, 0.9)
+
+	err := i.SetCrop(0.2, 0.3, 0.5, 0.6)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"image":{"url":"https://samples.clarifai.com/metro-north.jpg","crop":[0.2,0.3,0.5,0.6]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestImage_SetCrop_Invalid(t *testing.T) {
+
+	tests := [][4]float64{
+		{-0.1, 0, 0.5, 0.5},
+		{0, 0, 1.1, 0.5},
+		{0.5, 0, 0.5, 0.5},
+		{0, 0.6, 0.5, 0.5},
+	}
+
+	for _, tt := range tests {
+		i := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+
+		err := i.SetCrop(tt[0], tt[1], tt[2], tt[3])
+		if err != ErrInvalidCrop {
+			t.Errorf("Crop %v | Actual: %v, expected: %v", tt, err, ErrInvalidCrop)
+		}
+
+		if i.Properties.Crop != nil {
+			t.Errorf("Crop %v | Invalid crop should not be set, got %v", tt, i.Properties.Crop)
+		}
+	}
+}
+
 func TestImage_AddConcept(t *testing.T) {
 
 	i := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
@@ -121,3 +273,24 @@ func TestImage_AllowDuplicates(t *testing.T) {
 		t.Errorf("Actual: %v, expected: %v", i.Properties.AllowDuplicateURL, true)
 	}
 }
+
+func TestImage_validate(t *testing.T) {
+
+	var tests = []struct {
+		image    *Image
+		expected error
+	}{
+		{NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), nil},
+		{&Image{Properties: &ImageProperties{Base64: TestImageBase64}}, nil},
+		{&Image{Video: &Video{URL: "https://samples.clarifai.com/beer.mp4"}}, nil},
+		{&Image{}, ErrEmptyImageData},
+		{&Image{Properties: &ImageProperties{}}, ErrEmptyImageData},
+		{&Image{Properties: &ImageProperties{URL: "https://samples.clarifai.com/metro-north.jpg", Base64: TestImageBase64}}, ErrAmbiguousImageData},
+	}
+
+	for i, tt := range tests {
+		if err := tt.image.validate(); err != tt.expected {
+			t.Errorf("Case %d | Actual: %v, expected: %v", i, err, tt.expected)
+		}
+	}
+}
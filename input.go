@@ -1,6 +1,22 @@
 package clarifai
 
-import "net/http"
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// inputFetchConcurrency is a maximum number of concurrent calls made by GetInputsByIDs.
+const inputFetchConcurrency = 8
 
 type Input struct {
 	Data      *Image         `json:"data,omitempty"`
@@ -10,8 +26,44 @@ type Input struct {
 }
 
 type Inputs struct {
-	Inputs  []*Input `json:"inputs"`
-	modelID string   `json:"-"`
+	Inputs         []*Input       `json:"inputs"`
+	modelID        string         `json:"-"`
+	modelVersionID string         `json:"-"`
+	outputConfig   *PredictConfig `json:"-"` // Optional predict settings.
+	publicModel    bool           `json:"-"` // Predict with a public model, even if an application has a model with the same ID.
+	limit          int            `json:"-"` // Maximum number of inputs, InputLimit if 0.
+}
+
+// SupportedLanguages is a map of languages supported for concept names in predictions
+// as per https://clarifai.com/developer/guide/languages
+var SupportedLanguages map[string]struct{}
+
+func init() {
+	SupportedLanguages = map[string]struct{}{
+		"ar":    struct{}{},
+		"bn":    struct{}{},
+		"da":    struct{}{},
+		"de":    struct{}{},
+		"en":    struct{}{},
+		"es":    struct{}{},
+		"fi":    struct{}{},
+		"fr":    struct{}{},
+		"hi":    struct{}{},
+		"hu":    struct{}{},
+		"it":    struct{}{},
+		"ja":    struct{}{},
+		"ko":    struct{}{},
+		"nl":    struct{}{},
+		"no":    struct{}{},
+		"pa":    struct{}{},
+		"pl":    struct{}{},
+		"pt":    struct{}{},
+		"ru":    struct{}{},
+		"sv":    struct{}{},
+		"tr":    struct{}{},
+		"zh":    struct{}{},
+		"zh-TW": struct{}{},
+	}
 }
 
 // InitInputs returns a default inputs object.
@@ -21,12 +73,87 @@ func InitInputs() *Inputs {
 	}
 }
 
+// InitInputs returns a default inputs object, which respects the input limit of the session, see SetInputLimit.
+func (s *Session) InitInputs() *Inputs {
+	i := InitInputs()
+	i.limit = s.inputLimit
+	return i
+}
+
+// SetInputLimit sets the maximum number of inputs, e.g. for an on-premise deployment with another batch size.
+// Default is InputLimit.
+func (i *Inputs) SetInputLimit(n int) error {
+	if n <= 0 {
+		return ErrInvalidInputLimit
+	}
+
+	i.limit = n
+	return nil
+}
+
+// maxInputs returns the maximum number of inputs.
+func (i *Inputs) maxInputs() int {
+	if i.limit == 0 {
+		return InputLimit
+	}
+
+	return i.limit
+}
+
+// inputIDMaxLength is a maximum length of a custom input ID.
+const inputIDMaxLength = 255
+
+// validateInputID checks that a custom input ID is at most 255 characters of letters, digits, "-" and "_".
+// An empty ID is valid, API generates one in this case.
+func validateInputID(id string) error {
+
+	if len(id) > inputIDMaxLength {
+		return ErrInvalidInputID
+	}
+
+	for _, c := range id {
+		if !isInputIDChar(c) {
+			return ErrInvalidInputID
+		}
+	}
+
+	return nil
+}
+
+func isInputIDChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_'
+}
+
+// SanitizeInputID converts a string into a valid input ID, replacing disallowed characters with "_"
+// and truncating it to the maximum length.
+func SanitizeInputID(s string) string {
+
+	id := make([]byte, 0, len(s))
+	for _, c := range s {
+		if isInputIDChar(c) {
+			id = append(id, byte(c))
+		} else {
+			id = append(id, '_')
+		}
+	}
+
+	if len(id) > inputIDMaxLength {
+		id = id[:inputIDMaxLength]
+	}
+
+	return string(id)
+}
+
 // AddInput adds an image input to a request.
 func (i *Inputs) AddInput(im *Image, id string) error {
-	if len(i.Inputs) >= InputLimit {
+	if len(i.Inputs) >= i.maxInputs() {
 		return ErrInputLimitReached
 	}
 
+	if err := validateInputID(id); err != nil {
+		return err
+	}
+
 	in := &Input{
 		Data: im,
 	}
@@ -40,9 +167,156 @@ func (i *Inputs) AddInput(im *Image, id string) error {
 	return nil
 }
 
+// AddInputWithConcepts adds an image input with concepts to a request, concepts map IDs to values.
+func (i *Inputs) AddInputWithConcepts(im *Image, id string, concepts map[string]interface{}) error {
+	if im == nil {
+		return ErrNilInput
+	}
+
+	if err := i.AddInput(im, id); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(concepts))
+	for c := range concepts {
+		ids = append(ids, c)
+	}
+	sort.Strings(ids)
+
+	for _, c := range ids {
+		im.AddConcept(c, concepts[c])
+	}
+
+	return nil
+}
+
+// AddText adds a text input to a request.
+func (i *Inputs) AddText(t *Text, id string) error {
+	if len(i.Inputs) >= i.maxInputs() {
+		return ErrInputLimitReached
+	}
+
+	if err := validateInputID(id); err != nil {
+		return err
+	}
+
+	i.Inputs = append(i.Inputs, &Input{
+		Data: &Image{
+			Text: t,
+		},
+		ID: id,
+	})
+	return nil
+}
+
+// AddVideo adds a video input to a request.
+func (i *Inputs) AddVideo(v *Video, id string) error {
+	if len(i.Inputs) >= i.maxInputs() {
+		return ErrInputLimitReached
+	}
+
+	if err := validateInputID(id); err != nil {
+		return err
+	}
+
+	i.Inputs = append(i.Inputs, &Input{
+		Data: &Image{
+			Video: v,
+		},
+		ID: id,
+	})
+	return nil
+}
+
 // SetModel is an optional model setter for predict calls.
 func (i *Inputs) SetModel(m string) {
 	i.modelID = m
+	i.modelVersionID = ""
+	i.publicModel = false
+}
+
+// SetPublicModel is an optional setter of a public model for predict calls, e.g. PublicModelFood.
+// Predictions are made by the public model, even if the application has a custom model with the same ID.
+func (i *Inputs) SetPublicModel(m string) {
+	i.SetModel(m)
+	i.publicModel = true
+}
+
+// Model returns the model predict calls are issued to, PublicModelGeneral unless another one is set.
+func (i *Inputs) Model() string {
+	if i.modelID == "" {
+		return PublicModelGeneral
+	}
+	return i.modelID
+}
+
+// SetModelVersion is an optional setter to pin predict calls to a specific version of a model.
+func (i *Inputs) SetModelVersion(modelID, versionID string) {
+	i.modelID = modelID
+	i.modelVersionID = versionID
+	i.publicModel = false
+}
+
+// SetLanguage is an optional setter of the language of concept names returned by predict calls.
+func (i *Inputs) SetLanguage(lang string) error {
+	if _, ok := SupportedLanguages[lang]; !ok {
+		return ErrUnsupportedLanguage
+	}
+
+	i.config().Language = lang
+	return nil
+}
+
+// SetMaxConcepts is an optional setter of the maximum number of concepts returned by predict calls.
+func (i *Inputs) SetMaxConcepts(n int) error {
+	if n <= 0 {
+		return ErrInvalidMaxConcepts
+	}
+
+	i.config().MaxConcepts = n
+	return nil
+}
+
+// SetMinValue is an optional setter of the minimum value of concepts returned by predict calls.
+func (i *Inputs) SetMinValue(v float64) error {
+	if v < 0 || v > 1 {
+		return ErrInvalidMinValue
+	}
+
+	i.config().MinValue = v
+	return nil
+}
+
+// SetUseCache is an optional setter allowing predict calls to return cached predictions.
+func (i *Inputs) SetUseCache(b bool) {
+	i.config().UseCache = Bool(b)
+}
+
+// SelectConcepts is an optional setter of concept IDs which predict calls are limited to.
+// An empty list removes the limitation.
+func (i *Inputs) SelectConcepts(ids []string) {
+	if len(ids) == 0 {
+		if i.outputConfig != nil {
+			i.outputConfig.SelectConcepts = nil
+		}
+		return
+	}
+
+	concepts := make([]*OutputConcept, len(ids))
+	for n, id := range ids {
+		concepts[n] = &OutputConcept{ID: id}
+	}
+
+	i.config().SelectConcepts = concepts
+}
+
+// config returns the predict output configuration, creating it if necessary.
+func (i *Inputs) config() *PredictConfig {
+	if i.outputConfig == nil {
+		i.outputConfig = &PredictConfig{}
+	}
+
+	return i.outputConfig
 }
 
 // AddConcept adds concepts to input.
@@ -58,6 +332,39 @@ func (i *Input) AddConcept(id string, value interface{}) {
 	})
 }
 
+// Concepts returns concepts of an input ("input" -> "data" -> "concepts") as typed values.
+// Boolean concept values are converted to 1 and 0.
+func (i *Input) Concepts() []*OutputConcept {
+
+	if i.Data == nil {
+		return nil
+	}
+
+	concepts := make([]*OutputConcept, 0, len(i.Data.Concepts))
+	for _, m := range i.Data.Concepts {
+		c := &OutputConcept{}
+		c.ID, _ = m["id"].(string)
+		c.Name, _ = m["name"].(string)
+
+		if appID, ok := m["app_id"].(string); ok {
+			c.AppID = &appID
+		}
+
+		switch v := m["value"].(type) {
+		case float64:
+			c.Value = v
+		case bool:
+			if v {
+				c.Value = 1
+			}
+		}
+
+		concepts = append(concepts, c)
+	}
+
+	return concepts
+}
+
 // SetMetadata adds metadata to a query input item ("input" -> "data" -> "metadata").
 func (q *Input) SetMetadata(i interface{}) {
 	if q.Data == nil {
@@ -66,33 +373,484 @@ func (q *Input) SetMetadata(i interface{}) {
 	q.Data.Metadata = i
 }
 
+// SetGeoPoint adds a geo location to an input ("input" -> "data" -> "geo" -> "geo_point").
+func (i *Input) SetGeoPoint(longitude, latitude float64) error {
+
+	p, err := newGeoPoint(longitude, latitude)
+	if err != nil {
+		return err
+	}
+
+	if i.Data == nil {
+		i.Data = &Image{}
+	}
+	i.Data.Geo = &Geo{
+		GeoPoint: p,
+	}
+
+	return nil
+}
+
 // AddInputs builds a request to add inputs to the API.
 func (s *Session) AddInputs(p *Inputs) *Request {
 
 	r := NewRequest(s, http.MethodPost, "inputs")
 	r.SetPayload(p)
+	r.err = validateInputs(p.Inputs, false)
 
 	return r
 }
 
+// CreateInputs adds inputs and returns them as created by API, with server-assigned IDs, creation times and statuses.
+// On a partial failure the inputs are returned along with an *APIError, failed inputs have their own statuses.
+func (s *Session) CreateInputs(p *Inputs) ([]*Input, error) {
+
+	resp, err := s.AddInputs(p).Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	return resp.Inputs, err
+}
+
+// AddInputsResult holds IDs of inputs added by AddInputsIdempotent.
+type AddInputsResult struct {
+	Created  []string // Inputs created by the call.
+	Existing []string // Inputs that had already existed, e.g. added by a previous attempt.
+}
+
+// AddInputsIdempotent adds inputs in a way that is safe to retry.
+// Inputs without an ID get a deterministic one derived from their data, so a retried call
+// sends the same IDs and inputs rejected by API as duplicate IDs are reported as existing.
+// Other failed inputs are returned in a MultiError along with the result.
+func (s *Session) AddInputsIdempotent(p *Inputs) (*AddInputsResult, error) {
+
+	if err := validateInputs(p.Inputs, false); err != nil {
+		return nil, err
+	}
+
+	for _, in := range p.Inputs {
+		if in.ID != "" {
+			continue
+		}
+
+		id, err := deterministicInputID(in)
+		if err != nil {
+			return nil, err
+		}
+		in.ID = id
+	}
+
+	resp, err := s.AddInputs(p).Do()
+	if resp == nil || len(resp.Inputs) == 0 {
+		return nil, err
+	}
+
+	res := &AddInputsResult{}
+	var errs MultiError
+
+	for _, in := range resp.Inputs {
+		switch {
+		case in.Status == nil, in.Status.IsSuccess(), in.Status.IsPending():
+			res.Created = append(res.Created, in.ID)
+		case isDuplicateInputID(in.Status):
+			res.Existing = append(res.Existing, in.ID)
+		default:
+			errs = append(errs, fmt.Errorf("Input %s: %w", in.ID, in.Status.Error()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return res, errs
+	}
+
+	return res, nil
+}
+
+// isDuplicateInputID reports whether an input is rejected by API because of a duplicate ID.
+// API reports it as a generic invalid argument, telling the reason in details only.
+func isDuplicateInputID(st *ServiceStatus) bool {
+	return st.Code == StatusInputInvalidArgument && strings.Contains(strings.ToLower(st.Details), "duplicate id")
+}
+
+// deterministicInputID derives an input ID from a hash of input data.
+func deterministicInputID(in *Input) (string, error) {
+
+	b, err := json.Marshal(in.Data)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha1.Sum(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// AddInputsBatched splits inputs into chunks of at most InputLimit items, or the limit set by SetInputLimit,
+// and builds an add-inputs request per chunk, preserving the order of inputs.
+// Each returned request must be executed separately.
+func (s *Session) AddInputsBatched(inputs []*Input) ([]*Request, error) {
+
+	requests := []*Request{}
+	limit := s.maxInputs()
+
+	for start := 0; start < len(inputs); start += limit {
+		end := start + limit
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		p := s.InitInputs()
+		for _, in := range inputs[start:end] {
+			if in == nil {
+				return nil, ErrNilInput
+			}
+			p.Inputs = append(p.Inputs, in)
+		}
+
+		requests = append(requests, s.AddInputs(p))
+	}
+
+	return requests, nil
+}
+
+// MergeInputs combines inputs of several parts and splits them into chunks of at most InputLimit items,
+// preserving the order of inputs. Chunks have model and output settings of the parts, which should be the same for all parts,
+// and the input limit of the first part.
+func MergeInputs(parts ...*Inputs) ([]*Inputs, error) {
+
+	var first *Inputs
+	var inputs []*Input
+
+	for _, p := range parts {
+		if p == nil {
+			continue
+		}
+
+		if first == nil {
+			first = p
+		} else if !sameModelSettings(first, p) {
+			return nil, ErrConflictingModels
+		}
+
+		inputs = append(inputs, p.Inputs...)
+	}
+
+	chunks := []*Inputs{}
+	if first == nil {
+		return chunks, nil
+	}
+
+	limit := first.maxInputs()
+
+	for start := 0; start < len(inputs); start += limit {
+		end := start + limit
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		c := &Inputs{
+			modelID:        first.modelID,
+			modelVersionID: first.modelVersionID,
+			outputConfig:   first.outputConfig.copy(),
+			publicModel:    first.publicModel,
+			limit:          first.limit,
+		}
+		c.Inputs = append(c.Inputs, inputs[start:end]...)
+
+		chunks = append(chunks, c)
+	}
+
+	return chunks, nil
+}
+
+// copy returns a copy of predict settings, so settings of inputs sharing them can be changed independently.
+func (c *PredictConfig) copy() *PredictConfig {
+	if c == nil {
+		return nil
+	}
+
+	cfg := *c
+	if c.SelectConcepts != nil {
+		cfg.SelectConcepts = append([]*OutputConcept(nil), c.SelectConcepts...)
+	}
+	if c.UseCache != nil {
+		cfg.UseCache = Bool(*c.UseCache)
+	}
+
+	return &cfg
+}
+
+// sameModelSettings reports whether predict calls of inputs go to the same model with the same output settings.
+func sameModelSettings(a, b *Inputs) bool {
+	return a.Model() == b.Model() &&
+		a.modelVersionID == b.modelVersionID &&
+		a.publicModel == b.publicModel &&
+		reflect.DeepEqual(a.outputConfig, b.outputConfig)
+}
+
+// validateInputs checks inputs before they are sent to add-inputs and predict calls.
+// Streamed images are only allowed in streamed requests.
+func validateInputs(inputs []*Input, streamed bool) error {
+
+	for _, in := range inputs {
+		if in == nil {
+			return ErrNilInput
+		}
+
+		if in.Data == nil {
+			return ErrEmptyImageData
+		}
+
+		if err := in.Data.validate(); err != nil {
+			return err
+		}
+
+		if in.Data.file != "" && !streamed {
+			return ErrStreamedImage
+		}
+	}
+
+	return nil
+}
+
 // GetAllInputs fetches a list of all inputs.
 func (s *Session) GetAllInputs() *Request {
 
 	return NewRequest(s, http.MethodGet, "inputs")
 }
 
+// GetAllInputsWithPagination fetches a single page of inputs.
+// Page numbers start at 1, perPage should be between 1 and 1000.
+func (s *Session) GetAllInputsWithPagination(page, perPage int) *Request {
+
+	r := s.GetAllInputs().WithPagination(page, perPage)
+	r.err = validatePagination(page, perPage)
+
+	return r
+}
+
 // GetInput fetches one input.
 func (s *Session) GetInput(id string) *Request {
 
 	return NewRequest(s, http.MethodGet, "inputs/"+id)
 }
 
+// InputExists reports whether an input with a given ID exists.
+// A missing input is not an error, while transport and other API errors are returned.
+func (s *Session) InputExists(id string) (bool, error) {
+
+	_, err := s.GetInput(id).Do()
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// isNotFound reports whether API failed to find a requested resource.
+func isNotFound(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && (e.HTTPStatus == http.StatusNotFound || e.Code == StatusDoesNotExist)
+}
+
+// GetInputConcepts fetches one input and returns its concepts, e.g. ones set by UpdateInputConcepts.
+func (s *Session) GetInputConcepts(id string) ([]*OutputConcept, error) {
+
+	resp, err := s.GetInput(id).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Input == nil {
+		return nil, nil
+	}
+
+	return resp.Input.Concepts(), nil
+}
+
+// GetInputsByIDs fetches multiple inputs concurrently. Results preserve the order of ids.
+// Inputs that failed to fetch are nil in the result, and their errors are returned as a MultiError.
+func (s *Session) GetInputsByIDs(ids []string) ([]*Input, error) {
+
+	inputs := make([]*Input, len(ids))
+	errs := make([]error, len(ids))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < inputFetchConcurrency && w < len(ids); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				resp, err := s.GetInput(ids[j]).Do()
+				if err != nil {
+					errs[j] = fmt.Errorf("Input %s: %w", ids[j], err)
+					continue
+				}
+				inputs[j] = resp.Input
+			}
+		}()
+	}
+
+	for j := range ids {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+
+	var multiErr MultiError
+	for _, err := range errs {
+		if err != nil {
+			multiErr = append(multiErr, err)
+		}
+	}
+
+	if len(multiErr) > 0 {
+		return inputs, multiErr
+	}
+
+	return inputs, nil
+}
+
 // GetInputStatuses fetches statuses of all inputs.
 func (s *Session) GetInputStatuses() *Request {
 
 	return NewRequest(s, http.MethodGet, "inputs/status")
 }
 
+// Default poll intervals of WaitForInputsProcessed.
+const (
+	defaultInputsPoll    = time.Second
+	defaultInputsMaxPoll = 30 * time.Second
+)
+
+// WaitOptions configures WaitForInputsProcessed.
+type WaitOptions struct {
+	Poll       time.Duration         // Initial poll interval, 1 second by default.
+	MaxPoll    time.Duration         // Maximum poll interval, 30 seconds by default.
+	OnProgress func(done, total int) // Optional callback called after every poll.
+}
+
+// WaitForInputsProcessed polls inputs until all of them are downloaded or failed. The poll interval grows by half
+// after every poll up to the maximum. Errors of failed inputs and inputs returned without a status are returned
+// as a MultiError with an error per input, a poll request error or a context error is returned immediately. nil opts means default options.
+func (s *Session) WaitForInputsProcessed(ctx context.Context, ids []string, opts *WaitOptions) error {
+
+	o := WaitOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Poll <= 0 {
+		o.Poll = defaultInputsPoll
+	}
+	if o.MaxPoll <= 0 {
+		o.MaxPoll = defaultInputsMaxPoll
+	}
+	if o.MaxPoll < o.Poll {
+		o.MaxPoll = o.Poll
+	}
+
+	pending := ids
+	poll := o.Poll
+	var errs MultiError
+
+	for {
+		var next []string
+
+		for _, id := range pending {
+			resp, err := s.GetInput(id).DoContext(ctx)
+			if err != nil {
+				return err
+			}
+
+			var status *ServiceStatus
+			if resp.Input != nil {
+				status = resp.Input.Status
+			}
+
+			switch {
+			case status == nil:
+				errs = append(errs, fmt.Errorf("Input %s: %w", id, ErrNoInputStatus))
+			case status.IsPending():
+				next = append(next, id)
+			case !status.IsSuccess():
+				errs = append(errs, fmt.Errorf("Input %s: %w", id, status.Error()))
+			}
+		}
+
+		if o.OnProgress != nil {
+			o.OnProgress(len(ids)-len(next), len(ids))
+		}
+
+		if len(next) == 0 {
+			break
+		}
+		pending = next
+
+		t := time.NewTimer(poll)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+
+		if poll += poll / 2; poll > o.MaxPoll {
+			poll = o.MaxPoll
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// InputCounts is a breakdown of inputs of an application by their processing state ("counts").
+type InputCounts struct {
+	Processed  int `json:"processed"`
+	ToProcess  int `json:"to_process"`
+	Errors     int `json:"errors"`
+	Processing int `json:"processing"`
+}
+
+// Total returns the total number of inputs.
+func (c *InputCounts) Total() int {
+	return c.Processed + c.ToProcess + c.Errors + c.Processing
+}
+
+// GetInputCounts fetches numbers of inputs of an application by their processing state.
+func (s *Session) GetInputCounts() (*InputCounts, error) {
+
+	resp, err := s.GetInputStatuses().Do()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Counts == nil {
+		return &InputCounts{}, nil
+	}
+
+	return resp.Counts, nil
+}
+
+// GetInputCount fetches the total number of inputs of an application.
+func (s *Session) GetInputCount() (int, error) {
+
+	c, err := s.GetInputCounts()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Total(), nil
+}
+
 // Payload for update/delete concepts of input
 type patchInputsPayload struct {
 	Action string        `json:"action"`
@@ -109,7 +867,8 @@ func newPatchInputsPayload(action string) *patchInputsPayload {
 type patchInput struct {
 	Id   string `json:"id"`
 	Data struct {
-		Concepts []interface{} `json:"concepts"`
+		Concepts []interface{}          `json:"concepts,omitempty"`
+		Metadata map[string]interface{} `json:"metadata,omitempty"`
 	} `json:"data"`
 }
 
@@ -119,16 +878,14 @@ func newPatchInput(id string) *patchInput {
 	return p
 }
 
-func (p *patchInput) addConcept(id string, val, ignoreVal bool) {
+// addConcept adds a concept with a value, e.g. 1 for a present concept or a probability of a label.
+// The value is omitted if it is nil.
+func (p *patchInput) addConcept(id string, value interface{}) {
 	c := map[string]interface{}{
 		"id": id,
 	}
-	if !ignoreVal {
-		if val {
-			c["value"] = 1
-		} else {
-			c["value"] = 0
-		}
+	if value != nil {
+		c["value"] = value
 	}
 	p.Data.Concepts = append(p.Data.Concepts, c)
 }
@@ -144,8 +901,29 @@ func (s *Session) DeleteInputConcepts(id string, concepts []string) *Request {
 	i := newPatchInput(id)
 
 	for _, v := range concepts {
-		i.addConcept(v, false, true)
+		i.addConcept(v, nil)
+	}
+	p.Inputs = append(p.Inputs, i)
+
+	r.SetPayload(p)
+
+	return r
+}
+
+// UpdateInputMetadata updates metadata of an input by its ID.
+// With merge, provided keys are added to existing metadata, otherwise metadata is overwritten.
+func (s *Session) UpdateInputMetadata(id string, metadata map[string]interface{}, merge bool) *Request {
+
+	r := NewRequest(s, http.MethodPatch, "inputs")
+
+	action := "overwrite"
+	if merge {
+		action = "merge"
 	}
+
+	p := newPatchInputsPayload(action)
+	i := newPatchInput(id)
+	i.Data.Metadata = metadata
 	p.Inputs = append(p.Inputs, i)
 
 	r.SetPayload(p)
@@ -156,22 +934,152 @@ func (s *Session) DeleteInputConcepts(id string, concepts []string) *Request {
 // UpdateInputConcepts updates existing and/or adds new concepts to an input by its ID.
 func (s *Session) UpdateInputConcepts(id string, userConcepts map[string]bool) *Request {
 
+	return s.patchInputConcepts(id, "merge", conceptValues(userConcepts))
+}
+
+// OverwriteInputConcepts replaces all concepts of an input by its ID with the provided ones.
+func (s *Session) OverwriteInputConcepts(id string, userConcepts map[string]bool) *Request {
+
+	return s.patchInputConcepts(id, "overwrite", conceptValues(userConcepts))
+}
+
+// UpdateInputConceptValues is like UpdateInputConcepts, but concepts have values between 0 and 1, e.g. probabilistic labels.
+func (s *Session) UpdateInputConceptValues(id string, userConcepts map[string]float64) *Request {
+
+	return s.patchInputConcepts(id, "merge", userConcepts)
+}
+
+// OverwriteInputConceptValues is like OverwriteInputConcepts, but concepts have values between 0 and 1.
+func (s *Session) OverwriteInputConceptValues(id string, userConcepts map[string]float64) *Request {
+
+	return s.patchInputConcepts(id, "overwrite", userConcepts)
+}
+
+// UpdateInputsConcepts updates existing and/or adds new concepts to multiple inputs in one call.
+// The updates map input IDs to their concepts.
+func (s *Session) UpdateInputsConcepts(updates map[string]map[string]bool) *Request {
+
+	r := NewRequest(s, http.MethodPatch, "inputs")
+
+	p := newPatchInputsPayload("merge")
+	for _, id := range sortedKeys(updates) {
+		p.Inputs = append(p.Inputs, newPatchInputConcepts(id, conceptValues(updates[id])))
+	}
+
+	r.SetPayload(p)
+
+	return r
+}
+
+// patchInputConcepts builds a request patching concepts of an input with a given action.
+func (s *Session) patchInputConcepts(id, action string, userConcepts map[string]float64) *Request {
+
 	// 1. Build a request.
 	r := NewRequest(s, http.MethodPatch, "inputs")
 
 	// 2. Add payload.
-	// Convert an input map into a map of concepts.
-	p := newPatchInputsPayload("merge")
+	p := newPatchInputsPayload(action)
+	p.Inputs = append(p.Inputs, newPatchInputConcepts(id, userConcepts))
+
+	r.SetPayload(p)
+
+	return r
+}
+
+// newPatchInputConcepts converts a map of concepts of an input into a patch sorted by concept IDs.
+func newPatchInputConcepts(id string, userConcepts map[string]float64) *patchInput {
+
 	i := newPatchInput(id)
 
-	for id, value := range userConcepts {
-		i.addConcept(id, value, false)
+	ids := make([]string, 0, len(userConcepts))
+	for id := range userConcepts {
+		ids = append(ids, id)
 	}
-	p.Inputs = append(p.Inputs, i)
+	sort.Strings(ids)
 
-	r.SetPayload(p)
+	for _, id := range ids {
+		i.addConcept(id, userConcepts[id])
+	}
 
-	return r
+	return i
+}
+
+// conceptValues converts presence of concepts to values, 1 for a present concept and 0 otherwise.
+func conceptValues(userConcepts map[string]bool) map[string]float64 {
+
+	values := make(map[string]float64, len(userConcepts))
+	for id, v := range userConcepts {
+		if v {
+			values[id] = 1
+		} else {
+			values[id] = 0
+		}
+	}
+
+	return values
+}
+
+// sortedKeys returns sorted input IDs of concept updates.
+func sortedKeys(updates map[string]map[string]bool) []string {
+
+	ids := make([]string, 0, len(updates))
+	for id := range updates {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// ReplaceInput replaces the image of an input, keeping its ID, e.g. when the content of the image URL has changed.
+// API cannot update input data in place, so the input is fetched, deleted and added again.
+// Concepts and metadata of the input are preserved unless the new image has its own: concepts are kept if the image
+// has no concepts, metadata is kept if the image has no metadata. The image itself is not modified.
+// A missing input is just added. The replacement is not atomic: if adding the new image fails, the input stays deleted.
+func (s *Session) ReplaceInput(id string, im *Image) (*Response, error) {
+
+	if id == "" {
+		return nil, ErrInvalidInputID
+	}
+	if im == nil {
+		return nil, ErrNilInput
+	}
+
+	// The image is validated before the input is deleted, its copy gets concepts and metadata of the input.
+	data := *im
+
+	p := s.InitInputs()
+	if err := p.AddInput(&data, id); err != nil {
+		return nil, err
+	}
+	if err := validateInputs(p.Inputs, false); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GetInput(id).Do()
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	if err == nil {
+		if old := resp.Input; old != nil && old.Data != nil {
+			if len(data.Concepts) == 0 {
+				data.Concepts = nil
+				for _, c := range old.Concepts() {
+					data.AddConcept(c.ID, c.Value)
+				}
+			}
+			if data.Metadata == nil {
+				data.Metadata = old.Data.Metadata
+			}
+		}
+
+		if _, err := s.DeleteInput(id).Do(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.AddInputs(p).Do()
 }
 
 // DeleteInput deletes a single input by its ID.
@@ -196,6 +1104,74 @@ func (s *Session) DeleteInputs(ids []string) *Request {
 	return r
 }
 
+// DeleteInputsByIDs deletes multiple inputs and returns a result per input ID:
+// nil for a deleted input, or an error reported by API for it, e.g. for an input that does not exist.
+// An error is returned only if the call failed as a whole.
+func (s *Session) DeleteInputsByIDs(ids []string) (map[string]error, error) {
+
+	resp, err := s.DeleteInputs(ids).Do()
+	if resp == nil || (err != nil && len(resp.Inputs) == 0) {
+		return nil, err
+	}
+
+	res := make(map[string]error, len(ids))
+	for _, id := range ids {
+		res[id] = nil
+	}
+
+	for _, in := range resp.Inputs {
+		if _, ok := res[in.ID]; ok {
+			res[in.ID] = in.Status.Error()
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteInputsBySearch deletes all inputs matching a search query and returns the number of deleted inputs.
+// Matching inputs are collected by SearchIterator first, so hits below the minimum score of the query are not deleted,
+// then deleted in batches of at most InputLimit inputs, or the limit set by SetInputLimit.
+func (s *Session) DeleteInputsBySearch(q *SearchRequest) (int, error) {
+
+	var ids []string
+	seen := map[string]struct{}{}
+
+	it := s.SearchIterator(q, maxItemsPerPageQty)
+	for {
+		h, ok, err := it.Next(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+
+		if h.Input == nil {
+			continue
+		}
+		if _, ok := seen[h.Input.ID]; !ok {
+			seen[h.Input.ID] = struct{}{}
+			ids = append(ids, h.Input.ID)
+		}
+	}
+
+	deleted := 0
+	limit := s.maxInputs()
+	for start := 0; start < len(ids); start += limit {
+		end := start + limit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		if _, err := s.DeleteInputs(ids[start:end]).Do(); err != nil {
+			return deleted, err
+		}
+		deleted += end - start
+	}
+
+	return deleted, nil
+}
+
 // DeleteAllInputs deletes all inputs.
 func (s *Session) DeleteAllInputs() *Request {
 
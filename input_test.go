@@ -1,9 +1,18 @@
 package clarifai
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestInitInputs(t *testing.T) {
@@ -51,6 +60,75 @@ func TestInputs_AddInput_Limit(t *testing.T) {
 	}
 }
 
+func TestInputs_AddInput_InvalidID(t *testing.T) {
+
+	i := InitInputs()
+	img := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+
+	for _, id := range []string{"foo bar", "foo/bar", "ünicode", strings.Repeat("a", 256)} {
+		if err := i.AddInput(img, id); err != ErrInvalidInputID {
+			t.Errorf("ID %q | Actual: %v, expected: %v", id, err, ErrInvalidInputID)
+		}
+	}
+
+	for _, id := range []string{"", "foo-bar_1", strings.Repeat("a", 255)} {
+		if err := i.AddInput(img, id); err != nil {
+			t.Errorf("ID %q | Should have no errors, but got %v", id, err)
+		}
+	}
+}
+
+func TestSanitizeInputID(t *testing.T) {
+
+	var tests = []struct {
+		id       string
+		expected string
+	}{
+		{"foo-bar_1", "foo-bar_1"},
+		{"foo bar/baz", "foo_bar_baz"},
+		{"ünicode", "_nicode"},
+		{strings.Repeat("a", 300), strings.Repeat("a", 255)},
+	}
+
+	for _, tt := range tests {
+		if actual := SanitizeInputID(tt.id); actual != tt.expected {
+			t.Errorf("Actual: %v, expected: %v", actual, tt.expected)
+		}
+	}
+}
+
+func TestInputs_AddInputWithConcepts(t *testing.T) {
+
+	i := InitInputs()
+
+	err := i.AddInputWithConcepts(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "foo", map[string]interface{}{
+		"train":  true,
+		"people": false,
+	})
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"concepts":[{"id":"people","value":false},{"id":"train","value":true}],"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}},"id":"foo"}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	for len(i.Inputs) < InputLimit {
+		i.Inputs = append(i.Inputs, &Input{})
+	}
+
+	err = i.AddInputWithConcepts(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "", nil)
+	if err != ErrInputLimitReached {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInputLimitReached)
+	}
+}
+
 func TestInputs_SetModel(t *testing.T) {
 
 	i := InitInputs()
@@ -64,6 +142,49 @@ func TestInputs_SetModel(t *testing.T) {
 	}
 }
 
+func TestInputs_Model(t *testing.T) {
+
+	i := InitInputs()
+
+	if i.Model() != PublicModelGeneral {
+		t.Errorf("Actual: %v, expected: %v", i.Model(), PublicModelGeneral)
+	}
+
+	i.SetModel(PublicModelFood)
+
+	if i.Model() != PublicModelFood {
+		t.Errorf("Actual: %v, expected: %v", i.Model(), PublicModelFood)
+	}
+
+	// A zero value falls back to the general model.
+	empty := &Inputs{}
+
+	if empty.Model() != PublicModelGeneral {
+		t.Errorf("Actual: %v, expected: %v", empty.Model(), PublicModelGeneral)
+	}
+}
+
+func TestInputs_SetModelVersion(t *testing.T) {
+
+	i := InitInputs()
+	i.SetModelVersion(PublicModelFood, "foo")
+
+	if i.modelID != PublicModelFood {
+		t.Errorf("Actual: %v, expected: %v", i.modelID, PublicModelFood)
+	}
+
+	if i.modelVersionID != "foo" {
+		t.Errorf("Actual: %v, expected: %v", i.modelVersionID, "foo")
+	}
+
+	// Setting a model drops a pinned version.
+	i.SetModel(PublicModelTravel)
+
+	if i.modelVersionID != "" {
+		t.Errorf("Actual: %v, expected: %v", i.modelVersionID, "")
+	}
+}
+
 func TestInput_AddConcept(t *testing.T) {
 
 	i := &Input{}
@@ -80,6 +201,25 @@ func TestInput_AddConcept(t *testing.T) {
 	}
 }
 
+func TestInput_Concepts(t *testing.T) {
+
+	i := &Input{}
+	if i.Concepts() != nil {
+		t.Errorf("Actual: %v, expected: %v", i.Concepts(), nil)
+	}
+
+	i.AddConcept("foo", true)
+	i.AddConcept("bar", false)
+
+	actual := i.Concepts()
+	expected := []*OutputConcept{
+		{Name: "foo", Value: 1},
+		{Name: "bar", Value: 0},
+	}
+
+	CompareStructs(t, expected, actual)
+}
+
 func TestInput_SetMetadata(t *testing.T) {
 	i := &Input{}
 
@@ -97,6 +237,30 @@ func TestInput_SetMetadata(t *testing.T) {
 	}
 }
 
+func TestInput_SetGeoPoint(t *testing.T) {
+	i := &Input{}
+
+	err := i.SetGeoPoint(-71.0589, 42.3601)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"data":{"geo":{"geo_point":{"longitude":-71.0589,"latitude":42.3601}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	err = i.SetGeoPoint(0, 91)
+	if err != ErrInvalidLatitude {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidLatitude)
+	}
+}
+
 func TestSession_GetAllInputs(t *testing.T) {
 
 	mockRoute(t, "inputs", "resp/ok_inputs.json")
@@ -158,3 +322,985 @@ func TestSession_AddInputs(t *testing.T) {
 		t.Fatal("Payload should not be nil.")
 	}
 }
+
+func TestSession_CreateInputs(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_10010_added_2_images_to_search_index_mixed_success.json")
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/puppy.jpeg"), "")
+
+	inputs, err := sess.CreateInputs(i)
+	if _, ok := err.(*APIError); !ok {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	if len(inputs) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(inputs), 2)
+	}
+
+	if inputs[0].ID != "c2b94a77f99b4d908574ade5533b9831" || inputs[0].Status.Code != 30100 {
+		t.Errorf("Actual: %+v, expected a failed input %v", inputs[0], "c2b94a77f99b4d908574ade5533b9831")
+	}
+
+	if inputs[1].ID != "e0b800a0eb444a80ac6f13073a15a548" || inputs[1].CreatedAt != "2016-11-26T23:32:21Z" {
+		t.Errorf("Actual: %+v, expected a created input %v", inputs[1], "e0b800a0eb444a80ac6f13073a15a548")
+	}
+}
+
+func TestSession_AddInputsIdempotent(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_10010_added_2_images_one_duplicate_id.json")
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "metro-north")
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/puppy.jpeg"), "puppy")
+
+	res, err := sess.AddInputsIdempotent(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := &AddInputsResult{
+		Created:  []string{"metro-north"},
+		Existing: []string{"puppy"},
+	}
+
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Actual: %+v, expected: %+v", res, expected)
+	}
+}
+
+func TestSession_AddInputsIdempotent_InvalidArgument(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_10010_added_2_images_one_invalid_argument.json")
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "metro-north")
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/puppy.jpeg"), "puppy")
+
+	res, err := sess.AddInputsIdempotent(i)
+
+	errs, ok := err.(MultiError)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Actual: %v, expected a MultiError of 1 error", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(errs[0], &apiErr) || apiErr.Code != StatusInputInvalidArgument {
+		t.Errorf("Actual: %v, expected an *APIError with code %v", errs[0], StatusInputInvalidArgument)
+	}
+
+	expected := &AddInputsResult{
+		Created: []string{"metro-north"},
+	}
+
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Actual: %+v, expected: %+v", res, expected)
+	}
+}
+
+func TestSession_AddInputsIdempotent_DeterministicIDs(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_inputs.json")
+
+	newInputs := func() *Inputs {
+		i := InitInputs()
+		_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+		_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/puppy.jpeg"), "")
+		return i
+	}
+
+	first, second := newInputs(), newInputs()
+	_, _ = sess.AddInputsIdempotent(first)
+	_, _ = sess.AddInputsIdempotent(second)
+
+	for j := range first.Inputs {
+		if first.Inputs[j].ID == "" || validateInputID(first.Inputs[j].ID) != nil {
+			t.Errorf("Actual: %q, expected a valid input ID", first.Inputs[j].ID)
+		}
+
+		if first.Inputs[j].ID != second.Inputs[j].ID {
+			t.Errorf("Actual: %v, expected: %v", second.Inputs[j].ID, first.Inputs[j].ID)
+		}
+	}
+
+	if first.Inputs[0].ID == first.Inputs[1].ID {
+		t.Errorf("Inputs with different data should get different IDs, got %v", first.Inputs[0].ID)
+	}
+}
+
+func TestSession_AddInputsIdempotent_Fail(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/fail_10010_added_2_images_to_search_index_duplicates.json")
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/puppy.jpeg"), "")
+
+	res, err := sess.AddInputsIdempotent(i)
+
+	errs, ok := err.(MultiError)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Actual: %v, expected a MultiError of 2 errors", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(errs[0], &apiErr) || apiErr.Code != 30100 {
+		t.Errorf("Actual: %v, expected an *APIError with code %v", errs[0], 30100)
+	}
+
+	if res == nil || len(res.Created) != 0 || len(res.Existing) != 0 {
+		t.Errorf("Actual: %+v, expected an empty result", res)
+	}
+}
+
+func TestSession_AddInputs_EmptyImage(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddInput(&Image{Properties: &ImageProperties{}}, "foo")
+
+	_, err := sess.AddInputs(i).Do()
+	if err != ErrEmptyImageData {
+		t.Errorf("Actual: %v, expected: %v", err, ErrEmptyImageData)
+	}
+}
+
+func TestSession_GetAllInputsWithPagination(t *testing.T) {
+
+	r := sess.GetAllInputsWithPagination(3, 100)
+	if r.err != nil {
+		t.Fatalf("Should have no errors, but got %v", r.err)
+	}
+
+	path := r.addPagination()
+
+	expected := "inputs?page=3&per_page=100"
+	if path != expected {
+		t.Errorf("Actual: %v, expected: %v", path, expected)
+	}
+}
+
+func TestSession_GetAllInputsWithPagination_Invalid(t *testing.T) {
+
+	tests := []struct {
+		page     int
+		perPage  int
+		expected error
+	}{
+		{0, 20, ErrInvalidPage},
+		{1, 0, ErrInvalidPerPage},
+		{1, 1001, ErrInvalidPerPage},
+	}
+
+	for _, tt := range tests {
+		_, err := sess.GetAllInputsWithPagination(tt.page, tt.perPage).Do()
+		if err != tt.expected {
+			t.Errorf("Page %d, per page %d | Actual: %v, expected: %v", tt.page, tt.perPage, err, tt.expected)
+		}
+	}
+}
+
+func TestSession_AddInputsBatched(t *testing.T) {
+
+	var inputs []*Input
+	for j := 0; j < InputLimit*2+1; j++ {
+		inputs = append(inputs, &Input{ID: strconv.Itoa(j)})
+	}
+
+	requests, err := sess.AddInputsBatched(inputs)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("Actual: %v, expected: %v", len(requests), 3)
+	}
+
+	expectedSizes := []int{InputLimit, InputLimit, 1}
+	n := 0
+	for j, r := range requests {
+		p := r.payload.(*Inputs)
+
+		if len(p.Inputs) != expectedSizes[j] {
+			t.Errorf("Chunk %d | Actual: %v, expected: %v", j, len(p.Inputs), expectedSizes[j])
+		}
+
+		for _, in := range p.Inputs {
+			if in.ID != strconv.Itoa(n) {
+				t.Fatalf("Order | Actual: %v, expected: %v", in.ID, n)
+			}
+			n++
+		}
+	}
+}
+
+func TestSession_AddInputsBatched_Empty(t *testing.T) {
+
+	requests, err := sess.AddInputsBatched(nil)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if requests == nil || len(requests) != 0 {
+		t.Errorf("Actual: %v, expected an empty slice", requests)
+	}
+}
+
+func TestSession_AddInputsBatched_NilInput(t *testing.T) {
+
+	_, err := sess.AddInputsBatched([]*Input{{ID: "foo"}, nil})
+	if err != ErrNilInput {
+		t.Errorf("Actual: %v, expected: %v", err, ErrNilInput)
+	}
+}
+
+func TestSession_SetInputLimit(t *testing.T) {
+
+	app := NewApp("test_api_key")
+
+	if err := app.SetInputLimit(0); err != ErrInvalidInputLimit {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidInputLimit)
+	}
+
+	if err := app.SetInputLimit(2); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	i := app.InitInputs()
+	for j := 0; j < 2; j++ {
+		if err := i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), ""); err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+	}
+	if err := i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), ""); err != ErrInputLimitReached {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInputLimitReached)
+	}
+
+	var inputs []*Input
+	for j := 0; j < 5; j++ {
+		inputs = append(inputs, &Input{ID: strconv.Itoa(j)})
+	}
+
+	requests, err := app.AddInputsBatched(inputs)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(requests) != 3 {
+		t.Errorf("Actual: %v, expected: %v", len(requests), 3)
+	}
+
+	if _, err := app.PredictImages(PublicModelGeneral, nil, nil, nil); err != ErrInputLimitReached {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInputLimitReached)
+	}
+}
+
+func TestInputs_SetInputLimit(t *testing.T) {
+
+	i := InitInputs()
+
+	if err := i.SetInputLimit(-1); err != ErrInvalidInputLimit {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidInputLimit)
+	}
+
+	if err := i.SetInputLimit(200); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	for j := 0; j < 200; j++ {
+		if err := i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), ""); err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+	}
+
+	chunks, err := MergeInputs(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(chunks) != 1 || len(chunks[0].Inputs) != 200 {
+		t.Errorf("Actual: %v chunks, expected a chunk of %v inputs", len(chunks), 200)
+	}
+}
+
+func TestSession_UpdateInputMetadata(t *testing.T) {
+
+	r := sess.UpdateInputMetadata("foo", map[string]interface{}{"event_type": "show"}, false)
+
+	if r.method != http.MethodPatch {
+		t.Errorf("Actual: %v, expected: %v", r.method, http.MethodPatch)
+	}
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"action":"overwrite","inputs":[{"id":"foo","data":{"metadata":{"event_type":"show"}}}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_UpdateInputMetadata_Merge(t *testing.T) {
+
+	r := sess.UpdateInputMetadata("foo", map[string]interface{}{"event_type": "show"}, true)
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"action":"merge","inputs":[{"id":"foo","data":{"metadata":{"event_type":"show"}}}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_OverwriteInputConcepts(t *testing.T) {
+
+	r := sess.OverwriteInputConcepts("foo", map[string]bool{"dog": true, "cat": false})
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"action":"overwrite","inputs":[{"id":"foo","data":{"concepts":[{"id":"cat","value":0},{"id":"dog","value":1}]}}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_ConceptValues(t *testing.T) {
+
+	// Add path.
+	i := InitInputs()
+	im := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+	im.AddConcept("train", 0.5)
+	_ = i.AddInput(im, "foo")
+
+	actual, err := json.Marshal(sess.AddInputs(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"concepts":[{"id":"train","value":0.5}],"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}},"id":"foo"}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	// Patch paths.
+	actual, err = json.Marshal(sess.UpdateInputConceptValues("foo", map[string]float64{"train": 0.5, "people": 1}).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"action":"merge","inputs":[{"id":"foo","data":{"concepts":[{"id":"people","value":1},{"id":"train","value":0.5}]}}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	actual, err = json.Marshal(sess.OverwriteInputConceptValues("foo", map[string]float64{"train": 0.5}).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"action":"overwrite","inputs":[{"id":"foo","data":{"concepts":[{"id":"train","value":0.5}]}}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_UpdateInputsConcepts(t *testing.T) {
+
+	r := sess.UpdateInputsConcepts(map[string]map[string]bool{
+		"foo": {"dog": true},
+		"bar": {"cat": false, "dog": true},
+	})
+
+	actual, err := json.Marshal(r.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"action":"merge","inputs":[{"id":"bar","data":{"concepts":[{"id":"cat","value":0},{"id":"dog","value":1}]}},{"id":"foo","data":{"concepts":[{"id":"dog","value":1}]}}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_GetInputConcepts(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs/ce9aeedd3be64cbd968861599412d5e6", "resp/ok_10000_get_one_input.json")
+
+	concepts, err := sess.GetInputConcepts("ce9aeedd3be64cbd968861599412d5e6")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(concepts) != 3 {
+		t.Fatalf("Actual: %v, expected: %v", len(concepts), 3)
+	}
+
+	appID := "c3915e768bf44e1eb469483642a664ef"
+	expected := &OutputConcept{
+		AppID: &appID,
+		ID:    "Dave Gahan",
+		Name:  "Dave Gahan",
+		Value: 1,
+	}
+
+	CompareStructs(t, expected, concepts[1])
+}
+
+func TestSession_GetInputsByIDs(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/"+apiVersion+"/inputs/")
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			printMock(t, w, "resp/404.json")
+			return
+		}
+
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"%s"}}`, id)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	ids := []string{"a", "b", "missing", "c", "d", "e", "f", "g", "h", "i"}
+
+	inputs, err := sess.GetInputsByIDs(ids)
+
+	multiErr, ok := err.(MultiError)
+	if !ok || len(multiErr) != 1 {
+		t.Fatalf("Actual: %v, expected a MultiError with 1 error", err)
+	}
+
+	for j, id := range ids {
+		if id == "missing" {
+			if inputs[j] != nil {
+				t.Errorf("Actual: %v, expected: %v", inputs[j], nil)
+			}
+			continue
+		}
+
+		if inputs[j] == nil || inputs[j].ID != id {
+			t.Errorf("Order | Actual: %v, expected: %v", inputs[j], id)
+		}
+	}
+}
+
+func TestSession_WaitForInputsProcessed(t *testing.T) {
+
+	serverReset()
+	var mu sync.Mutex
+	calls := map[string]int{}
+	mux.HandleFunc("/"+apiVersion+"/inputs/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/"+apiVersion+"/inputs/")
+
+		mu.Lock()
+		calls[id]++
+		n := calls[id]
+		mu.Unlock()
+
+		code := StatusInputDownloadSuccess
+		switch {
+		case id == "broken":
+			code = StatusInputDownloadFailed
+		case n < 3:
+			code = StatusInputDownloadPending
+		}
+
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"%s","status":{"code":%d}}}`, id, code)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	var progress []string
+	opts := &WaitOptions{
+		Poll:    time.Millisecond,
+		MaxPoll: 2 * time.Millisecond,
+		OnProgress: func(done, total int) {
+			progress = append(progress, fmt.Sprintf("%d/%d", done, total))
+		},
+	}
+
+	err := sess.WaitForInputsProcessed(context.Background(), []string{"foo", "broken", "bar"}, opts)
+
+	multiErr, ok := err.(MultiError)
+	if !ok || len(multiErr) != 1 {
+		t.Fatalf("Actual: %v, expected a MultiError with 1 error", err)
+	}
+
+	if !strings.HasPrefix(multiErr[0].Error(), "Input broken: ") {
+		t.Errorf("Actual: %v, expected an error of input %v", multiErr[0], "broken")
+	}
+
+	if fmt.Sprint(progress) != "[1/3 1/3 3/3]" {
+		t.Errorf("Actual: %v, expected: %v", progress, "[1/3 1/3 3/3]")
+	}
+
+	if calls["foo"] != 3 || calls["bar"] != 3 || calls["broken"] != 1 {
+		t.Errorf("Actual: %v, expected 3 polls of pending inputs and 1 of the failed one", calls)
+	}
+}
+
+func TestSession_WaitForInputsProcessed_NoStatus(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/"+apiVersion+"/inputs/")
+
+		switch id {
+		case "no-input":
+			fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"}}`)
+		case "no-status":
+			fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"no-status"}}`)
+		default:
+			fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"%s","status":{"code":%d}}}`, id, StatusInputDownloadSuccess)
+		}
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var progress []string
+	opts := &WaitOptions{
+		Poll: time.Millisecond,
+		OnProgress: func(done, total int) {
+			progress = append(progress, fmt.Sprintf("%d/%d", done, total))
+		},
+	}
+
+	err := sess.WaitForInputsProcessed(ctx, []string{"no-input", "no-status", "foo"}, opts)
+
+	multiErr, ok := err.(MultiError)
+	if !ok || len(multiErr) != 2 {
+		t.Fatalf("Actual: %v, expected a MultiError with 2 errors", err)
+	}
+
+	for _, e := range multiErr {
+		if !errors.Is(e, ErrNoInputStatus) {
+			t.Errorf("Actual: %v, expected: %v", e, ErrNoInputStatus)
+		}
+	}
+
+	if fmt.Sprint(progress) != fmt.Sprint([]string{"3/3"}) {
+		t.Errorf("Actual: %v, expected: %v", progress, []string{"3/3"})
+	}
+}
+
+func TestSession_WaitForInputsProcessed_Cancel(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"foo","status":{"code":%d}}}`, StatusInputDownloadPending)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := sess.WaitForInputsProcessed(ctx, []string{"foo"}, &WaitOptions{Poll: 5 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Actual: %v, expected: %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSession_DeleteInputsBySearch(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/searches", func(w http.ResponseWriter, r *http.Request) {
+		hits := make([]string, 0, 131)
+		for i := 0; i < 130; i++ {
+			hits = append(hits, fmt.Sprintf(`{"score":1,"input":{"id":"input-%d"}}`, i))
+		}
+		hits = append(hits, `{"score":0.9,"input":{"id":"input-0"}}`) // a duplicate hit
+
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"hits":[%s]}`, strings.Join(hits, ","))
+	})
+
+	var batches []int
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		var p struct {
+			IDs []string `json:"ids"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&p)
+		batches = append(batches, len(p.IDs))
+
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"}}`)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	q := NewAndSearchQuery()
+	q.WithUserConcept("blurry")
+
+	n, err := sess.DeleteInputsBySearch(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if n != 130 {
+		t.Errorf("Actual: %v, expected: %v", n, 130)
+	}
+
+	if fmt.Sprint(batches) != fmt.Sprint([]int{InputLimit, 130 - InputLimit}) {
+		t.Errorf("Actual: %v, expected: %v", batches, []int{InputLimit, 130 - InputLimit})
+	}
+}
+
+func TestSession_DeleteInputsBySearch_MinScore(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/searches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"hits":[`+
+			`{"score":0.95,"input":{"id":"similar"}},`+
+			`{"score":0.5,"input":{"id":"other-1"}},`+
+			`{"score":0.1,"input":{"id":"other-2"}}]}`)
+	})
+
+	var deleted []string
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		var p struct {
+			IDs []string `json:"ids"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&p)
+		deleted = append(deleted, p.IDs...)
+
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"}}`)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	q := NewAndSearchQuery()
+	q.WithImage(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"))
+	_ = q.SetMinScore(0.9)
+
+	n, err := sess.DeleteInputsBySearch(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if n != 1 || fmt.Sprint(deleted) != fmt.Sprint([]string{"similar"}) {
+		t.Errorf("Actual: %v, %v, expected: %v, %v", n, deleted, 1, []string{"similar"})
+	}
+}
+
+func TestSession_DeleteInputsBySearch_Empty(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/searches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"hits":[]}`)
+	})
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No inputs should be deleted")
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	n, err := sess.DeleteInputsBySearch(NewAndSearchQuery())
+	if err != nil || n != 0 {
+		t.Errorf("Actual: %v, %v, expected: %v, %v", n, err, 0, nil)
+	}
+}
+
+func TestSession_GetInputCount(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"counts":{"processed":10,"to_process":3,"errors":1,"processing":2}}`)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	c, err := sess.GetInputCounts()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := &InputCounts{Processed: 10, ToProcess: 3, Errors: 1, Processing: 2}
+	CompareStructs(t, expected, c)
+
+	n, err := sess.GetInputCount()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if n != 16 {
+		t.Errorf("Actual: %v, expected: %v", n, 16)
+	}
+}
+
+func TestSession_InputExists(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/", func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, "/"+apiVersion+"/inputs/") {
+		case "missing":
+			w.WriteHeader(http.StatusNotFound)
+			printMock(t, w, "resp/404.json")
+		case "deleted":
+			fmt.Fprint(w, `{"status":{"code":11101,"description":"Resource does not exist"}}`)
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"foo"}}`)
+		}
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	cases := []struct {
+		id     string
+		exists bool
+		fails  bool
+	}{
+		{"foo", true, false},
+		{"missing", false, false},
+		{"deleted", false, false},
+		{"broken", false, true},
+	}
+
+	for _, c := range cases {
+		exists, err := sess.InputExists(c.id)
+
+		if (err != nil) != c.fails {
+			t.Errorf("%s | Actual: %v, expected an error: %v", c.id, err, c.fails)
+		}
+
+		if exists != c.exists {
+			t.Errorf("%s | Actual: %v, expected: %v", c.id, exists, c.exists)
+		}
+	}
+}
+
+func TestSession_DeleteInputsByIDs(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_10010_delete_inputs_mixed_success.json")
+
+	res, err := sess.DeleteInputsByIDs([]string{"foo", "bar"})
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(res) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(res), 2)
+	}
+
+	if res["foo"] != nil {
+		t.Errorf("Actual: %v, expected: %v", res["foo"], nil)
+	}
+
+	var apiErr *APIError
+	if !errors.As(res["bar"], &apiErr) || apiErr.Code != StatusDoesNotExist {
+		t.Errorf("Actual: %v, expected an *APIError with code %v", res["bar"], StatusDoesNotExist)
+	}
+}
+
+func TestSession_DeleteInputsByIDs_Fail(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/fail_11100_bad_req_invalid_json.json")
+
+	res, err := sess.DeleteInputsByIDs([]string{"foo"})
+	if _, ok := err.(*APIError); !ok {
+		t.Errorf("Actual: %v, expected an *APIError", err)
+	}
+
+	if res != nil {
+		t.Errorf("Actual: %v, expected: %v", res, nil)
+	}
+}
+
+func TestSession_ReplaceInput(t *testing.T) {
+
+	serverReset()
+
+	var calls []string
+
+	mux.HandleFunc("/"+apiVersion+"/inputs/foo", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"},"input":{"id":"foo","data":{`+
+				`"image":{"url":"https://samples.clarifai.com/old.jpg"},`+
+				`"concepts":[{"id":"dog","name":"dog","value":1,"app_id":"bar"}],`+
+				`"metadata":{"event_type":"show"}}}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"status":{"code":10000,"description":"Ok"}}`)
+	})
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"inputs":[{"data":{"concepts":[{"id":"dog","value":1}],"metadata":{"event_type":"show"},` +
+			`"image":{"url":"https://samples.clarifai.com/new.jpg"}},"id":"foo"}]}`
+
+		if string(body) != expected {
+			t.Errorf("Actual: %s, expected: %v", body, expected)
+		}
+
+		printMock(t, w, "resp/ok_10000_added_1_image_from_url.json")
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	im := NewImageFromURL("https://samples.clarifai.com/new.jpg")
+
+	_, err := sess.ReplaceInput("foo", im)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if im.Concepts != nil || im.Metadata != nil {
+		t.Errorf("Actual: %v and %v, expected the image to be unchanged", im.Concepts, im.Metadata)
+	}
+
+	expected := []string{http.MethodGet, http.MethodDelete, http.MethodPost}
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Errorf("Actual: %v, expected: %v", calls, expected)
+	}
+}
+
+func TestSession_ReplaceInput_Missing(t *testing.T) {
+
+	serverReset()
+
+	var calls []string
+
+	mux.HandleFunc("/"+apiVersion+"/inputs/foo", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+
+		w.WriteHeader(http.StatusNotFound)
+		printMock(t, w, "resp/404.json")
+	})
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+
+		printMock(t, w, "resp/ok_10000_added_1_image_from_url.json")
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	_, err := sess.ReplaceInput("foo", NewImageFromURL("https://samples.clarifai.com/new.jpg"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := []string{http.MethodGet, http.MethodPost}
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Errorf("Actual: %v, expected: %v", calls, expected)
+	}
+}
+
+func TestSession_ReplaceInput_NoID(t *testing.T) {
+
+	_, err := sess.ReplaceInput("", NewImageFromURL("https://samples.clarifai.com/new.jpg"))
+	if err != ErrInvalidInputID {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidInputID)
+	}
+}
+
+func TestSession_ReplaceInput_InvalidImage(t *testing.T) {
+
+	serverReset()
+
+	// No route is mocked: the image must be validated before the input is deleted.
+	_, err := sess.ReplaceInput("foo", &Image{})
+	if err != ErrEmptyImageData {
+		t.Errorf("Actual: %v, expected: %v", err, ErrEmptyImageData)
+	}
+}
+
+func TestMergeInputs(t *testing.T) {
+
+	newInputs := func(n int, prefix string) *Inputs {
+		i := InitInputs()
+		i.SetModel(PublicModelFood)
+		_ = i.SetMaxConcepts(5)
+		for j := 0; j < n; j++ {
+			_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), prefix+strconv.Itoa(j))
+		}
+		return i
+	}
+
+	chunks, err := MergeInputs(newInputs(100, "a"), nil, newInputs(100, "b"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(chunks) != 2 || len(chunks[0].Inputs) != InputLimit || len(chunks[1].Inputs) != 200-InputLimit {
+		t.Fatalf("Actual: %v chunks, expected chunks of %v and %v inputs", len(chunks), InputLimit, 200-InputLimit)
+	}
+
+	if chunks[0].Inputs[0].ID != "a0" || chunks[1].Inputs[len(chunks[1].Inputs)-1].ID != "b99" {
+		t.Errorf("Actual: %v and %v, expected: %v and %v", chunks[0].Inputs[0].ID, chunks[1].Inputs[len(chunks[1].Inputs)-1].ID, "a0", "b99")
+	}
+
+	for _, c := range chunks {
+		if c.Model() != PublicModelFood || c.outputConfig == nil || c.outputConfig.MaxConcepts != 5 {
+			t.Errorf("Actual: %v and %+v, expected model settings of the parts", c.Model(), c.outputConfig)
+		}
+	}
+
+	if chunks, err = MergeInputs(); err != nil || len(chunks) != 0 {
+		t.Errorf("Actual: %v and %v, expected no chunks", chunks, err)
+	}
+}
+
+func TestMergeInputs_OwnSettings(t *testing.T) {
+
+	newInputs := func() *Inputs {
+		i := InitInputs()
+		_ = i.SetMaxConcepts(5)
+		i.SelectConcepts([]string{"dog"})
+		i.SetUseCache(true)
+		for j := 0; j < 100; j++ {
+			_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+		}
+		return i
+	}
+
+	first := newInputs()
+	chunks, err := MergeInputs(first, newInputs())
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	_ = chunks[0].SetMaxConcepts(10)
+	_ = chunks[0].SetLanguage("zh")
+	chunks[0].SelectConcepts([]string{"cat"})
+	chunks[0].SetUseCache(false)
+
+	for _, i := range []*Inputs{first, chunks[1]} {
+		cfg := i.outputConfig
+		if cfg.MaxConcepts != 5 || cfg.Language != "" || cfg.SelectConcepts[0].ID != "dog" || !BoolValue(cfg.UseCache) {
+			t.Errorf("Actual: %+v, expected settings to be unchanged", cfg)
+		}
+	}
+}
+
+func TestMergeInputs_Conflict(t *testing.T) {
+
+	a := InitInputs()
+	b := InitInputs()
+	b.SetModel(PublicModelTravel)
+
+	if _, err := MergeInputs(a, b); err != ErrConflictingModels {
+		t.Errorf("Actual: %v, expected: %v", err, ErrConflictingModels)
+	}
+
+	c := InitInputs()
+	_ = c.SetLanguage("zh")
+
+	if _, err := MergeInputs(a, c); err != ErrConflictingModels {
+		t.Errorf("Actual: %v, expected: %v", err, ErrConflictingModels)
+	}
+}
@@ -0,0 +1,112 @@
+package clarifai
+
+import (
+	"context"
+	"fmt"
+)
+
+// InputIterator iterates over all inputs of an application, fetching pages lazily.
+type InputIterator struct {
+	session *Session
+	perPage int
+	page    int
+	inputs  []*Input // Fetched, but not yet returned inputs of the current page.
+	done    bool
+}
+
+// InputIterator returns an iterator over all inputs, fetching perPage inputs per call.
+func (s *Session) InputIterator(perPage int) *InputIterator {
+	return &InputIterator{
+		session: s,
+		perPage: perPage,
+		page:    defaultPage,
+	}
+}
+
+// Next returns the next input. When all inputs have been returned, it returns false.
+// Iteration stops after a page with fewer inputs than requested.
+func (it *InputIterator) Next(ctx context.Context) (*Input, bool, error) {
+
+	if len(it.inputs) == 0 && !it.done {
+		r := it.session.GetAllInputsWithPagination(it.page, it.perPage)
+		resp, err := r.DoContext(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		it.page++
+		it.inputs = resp.Inputs
+
+		if !r.pagination(len(it.inputs)).HasMore() {
+			it.done = true
+		}
+	}
+
+	if len(it.inputs) == 0 {
+		return nil, false, nil
+	}
+
+	in := it.inputs[0]
+	it.inputs = it.inputs[1:]
+
+	return in, true, nil
+}
+
+// SearchIterator iterates over all hits of a search query, fetching pages lazily.
+type SearchIterator struct {
+	session *Session
+	query   *SearchRequest
+	perPage int
+	page    int
+	hits    []*Hit // Fetched, but not yet returned hits of the current page.
+	done    bool
+}
+
+// SearchIterator returns an iterator over all hits of a search query, fetching perPage hits per call.
+func (s *Session) SearchIterator(q *SearchRequest, perPage int) *SearchIterator {
+	return &SearchIterator{
+		session: s,
+		query:   q,
+		perPage: perPage,
+		page:    defaultPage,
+	}
+}
+
+// Next returns the next hit. When all hits have been returned, it returns false.
+// Iteration stops after an empty page or a page with fewer hits than requested.
+// Hits below the minimum score of the query are skipped.
+func (it *SearchIterator) Next(ctx context.Context) (*Hit, bool, error) {
+
+	for len(it.hits) == 0 && !it.done {
+		if err := validatePagination(it.page, it.perPage); err != nil {
+			return nil, false, err
+		}
+
+		r := it.session.Search(it.query).WithPagination(it.page, it.perPage)
+		resp, err := r.DoContext(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("Search page %d: %w", it.page, err)
+		}
+
+		it.page++
+
+		if !r.pagination(len(resp.Hits)).HasMore() {
+			it.done = true
+		}
+
+		for _, h := range resp.Hits {
+			if h.Score >= it.query.minScore {
+				it.hits = append(it.hits, h)
+			}
+		}
+	}
+
+	if len(it.hits) == 0 {
+		return nil, false, nil
+	}
+
+	h := it.hits[0]
+	it.hits = it.hits[1:]
+
+	return h, true, nil
+}
@@ -0,0 +1,169 @@
+package clarifai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInputIterator_Next(t *testing.T) {
+
+	serverReset()
+
+	pages := map[string]string{
+		"1": `[{"id":"a"},{"id":"b"}]`,
+		"2": `[{"id":"c"}]`,
+	}
+	calls := 0
+
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		if r.URL.Query().Get("per_page") != "2" {
+			t.Errorf("Actual: %v, expected: %v", r.URL.Query().Get("per_page"), "2")
+		}
+
+		inputs, ok := pages[r.URL.Query().Get("page")]
+		if !ok {
+			inputs = `[]`
+		}
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"inputs":%s}`, inputs)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	it := sess.InputIterator(2)
+
+	var ids []string
+	for {
+		in, ok, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+		if !ok {
+			break
+		}
+		ids = append(ids, in.ID)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("Actual: %v, expected: %v", ids, expected)
+	}
+
+	// The second page is not full, so it is the last one.
+	if calls != 2 {
+		t.Errorf("Actual: %v, expected: %v", calls, 2)
+	}
+
+	// Exhausted iterator makes no more calls.
+	if _, ok, _ := it.Next(context.Background()); ok {
+		t.Error("Exhausted iterator should return no inputs")
+	}
+
+	if calls != 2 {
+		t.Errorf("Actual: %v, expected: %v", calls, 2)
+	}
+}
+
+func TestInputIterator_Next_InvalidPerPage(t *testing.T) {
+
+	it := sess.InputIterator(0)
+
+	_, _, err := it.Next(context.Background())
+	if err != ErrInvalidPerPage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidPerPage)
+	}
+}
+
+func TestSearchIterator_Next(t *testing.T) {
+
+	serverReset()
+
+	pages := map[int]string{
+		1: `[{"score":0.9,"input":{"id":"a"}},{"score":0.8,"input":{"id":"b"}}]`,
+		2: `[{"score":0.7,"input":{"id":"c"}},{"score":0.1,"input":{"id":"d"}}]`,
+	}
+	calls := 0
+
+	mux.HandleFunc("/"+apiVersion+"/searches", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		var q SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+
+		if q.Pagination == nil || q.Pagination.PerPage != 2 {
+			t.Fatalf("Actual: %+v, expected pagination with %v items per page", q.Pagination, 2)
+		}
+
+		hits, ok := pages[q.Pagination.Page]
+		if !ok {
+			hits = `[]`
+		}
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"hits":%s}`, hits)
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	q := NewAndSearchQuery()
+	q.WithAPIConcept("train")
+	_ = q.SetMinScore(0.5)
+
+	it := sess.SearchIterator(q, 2)
+
+	var ids []string
+	for {
+		h, ok, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+		if !ok {
+			break
+		}
+		ids = append(ids, h.Input.ID)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("Actual: %v, expected: %v", ids, expected)
+	}
+
+	// Both pages are full, so iteration ends with an empty third page.
+	if calls != 3 {
+		t.Errorf("Actual: %v, expected: %v", calls, 3)
+	}
+}
+
+func TestSearchIterator_Next_Fail(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "searches", "resp/fail_11100_bad_req_invalid_json.json")
+
+	it := sess.SearchIterator(NewAndSearchQuery(), 2)
+
+	_, ok, err := it.Next(context.Background())
+
+	var apiErr *APIError
+	if ok || !errors.As(err, &apiErr) {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	if !strings.HasPrefix(err.Error(), "Search page 1: ") {
+		t.Errorf("Actual: %v, expected an error of page %v", err, 1)
+	}
+}
+
+func TestSearchIterator_Next_InvalidPerPage(t *testing.T) {
+
+	it := sess.SearchIterator(NewAndSearchQuery(), 0)
+
+	_, _, err := it.Next(context.Background())
+	if err != ErrInvalidPerPage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidPerPage)
+	}
+}
@@ -0,0 +1,35 @@
+package clarifai
+
+import (
+	"net/http"
+	"time"
+)
+
+// Logger is called after every HTTP call of a session with its method, URL, response status and duration.
+// The status is 0 if no response has been received.
+type Logger func(method, url string, status int, duration time.Duration)
+
+// SetLogger sets a function called after every API call of the session, including retries.
+// A panic of the logger is recovered and does not affect the call. A nil logger disables logging, which is the default.
+func (s *Session) SetLogger(fn Logger) {
+	s.logger = fn
+}
+
+// log reports a finished HTTP call to the logger of the session, if any.
+func (s *Session) log(req *http.Request, res *http.Response, start time.Time) {
+
+	if s.logger == nil {
+		return
+	}
+
+	defer func() {
+		recover()
+	}()
+
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+	}
+
+	s.logger(req.Method, req.URL.String(), status, time.Since(start))
+}
@@ -0,0 +1,60 @@
+package clarifai
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSession_SetLogger(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/logged", func(w http.ResponseWriter, r *http.Request) {
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	var method, url string
+	var status int
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetLogger(func(m, u string, s int, d time.Duration) {
+		method, url, status = m, u, s
+	})
+
+	_, err := app.HTTPCall(http.MethodGet, "inputs/logged", nil)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if method != http.MethodGet {
+		t.Errorf("Actual: %v, expected: %v", method, http.MethodGet)
+	}
+
+	if url != ts.URL+"/v2/inputs/logged" {
+		t.Errorf("Actual: %v, expected: %v", url, ts.URL+"/v2/inputs/logged")
+	}
+
+	if status != http.StatusOK {
+		t.Errorf("Actual: %v, expected: %v", status, http.StatusOK)
+	}
+}
+
+func TestSession_SetLogger_Panic(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/logged", func(w http.ResponseWriter, r *http.Request) {
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetLogger(func(m, u string, s int, d time.Duration) {
+		panic("logger failure")
+	})
+
+	_, err := app.HTTPCall(http.MethodGet, "inputs/logged", nil)
+	if err != nil {
+		t.Errorf("Should have no errors, but got %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package clarifai
+
+import "net/http"
+
+// ModelMetrics is an evaluation result of a model version.
+type ModelMetrics struct {
+	Status         *ServiceStatus    `json:"status,omitempty"`
+	Summary        *MetricsSummary   `json:"summary,omitempty"`
+	MetricsByClass []*ConceptMetrics `json:"metrics_by_class,omitempty"` // Per-concept metrics.
+}
+
+// MetricsSummary holds metrics averaged across all concepts of a model.
+type MetricsSummary struct {
+	MacroAvgRocAuc    float64 `json:"macro_avg_roc_auc"`
+	MacroStdRocAuc    float64 `json:"macro_std_roc_auc"`
+	MacroAvgF1Score   float64 `json:"macro_avg_f1_score"`
+	MacroStdF1Score   float64 `json:"macro_std_f1_score"`
+	MacroAvgPrecision float64 `json:"macro_avg_precision"`
+	MacroAvgRecall    float64 `json:"macro_avg_recall"`
+}
+
+// ConceptMetrics holds metrics of a single concept of a model.
+type ConceptMetrics struct {
+	Concept   *OutputConcept `json:"concept,omitempty"`
+	NumPos    int            `json:"num_pos"`
+	NumNeg    int            `json:"num_neg"`
+	NumTot    int            `json:"num_tot"`
+	RocAuc    float64        `json:"roc_auc"`
+	F1        float64        `json:"f1"`
+	Precision float64        `json:"precision"`
+	Recall    float64        `json:"recall"`
+}
+
+// EvaluateModelVersion starts an evaluation of a trained model version.
+// Evaluation is asynchronous, use GetModelMetrics to fetch the results.
+func (s *Session) EvaluateModelVersion(m, v string) *Request {
+
+	return NewRequest(s, http.MethodPost, "models/"+m+"/versions/"+v+"/metrics")
+}
+
+// GetModelMetrics fetches evaluation metrics of a model version ("model_version" -> "metrics").
+func (s *Session) GetModelMetrics(m, v string) *Request {
+
+	return NewRequest(s, http.MethodGet, "models/"+m+"/versions/"+v+"/metrics")
+}
@@ -0,0 +1,54 @@
+package clarifai
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSession_EvaluateModelVersion(t *testing.T) {
+
+	r := sess.EvaluateModelVersion("foo", "bar")
+
+	if r.method != http.MethodPost {
+		t.Errorf("Actual: %v, expected: %v", r.method, http.MethodPost)
+	}
+
+	if r.path != "models/foo/versions/bar/metrics" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "models/foo/versions/bar/metrics")
+	}
+}
+
+func TestSession_GetModelMetrics(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/foo/versions/bar/metrics", "resp/ok_10000_get_model_metrics.json")
+
+	resp, err := sess.GetModelMetrics("foo", "bar").Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	m := resp.ModelVersion.Metrics
+	if m == nil {
+		t.Fatal("Metrics should not be nil")
+	}
+
+	expected := &MetricsSummary{
+		MacroAvgRocAuc:    0.9775,
+		MacroStdRocAuc:    0.0125,
+		MacroAvgF1Score:   0.91,
+		MacroStdF1Score:   0.04,
+		MacroAvgPrecision: 0.925,
+		MacroAvgRecall:    0.9,
+	}
+	CompareStructs(t, expected, m.Summary)
+
+	if len(m.MetricsByClass) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(m.MetricsByClass), 2)
+	}
+
+	c := m.MetricsByClass[1]
+	if c.Concept.ID != "concert" || c.RocAuc != 0.965 || c.F1 != 0.87 {
+		t.Errorf("Actual: %+v, expected metrics of concept %v", c, "concert")
+	}
+}
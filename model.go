@@ -1,7 +1,12 @@
 package clarifai
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"net/url"
+	"sync"
+	"time"
 )
 
 const (
@@ -25,6 +30,39 @@ const (
 
 	// PublicModelColor is a public model "color".
 	PublicModelColor = "eeed0b6733a644cea07cf4c60f87ebb7"
+
+	// PublicModelFaceDetection is a public model "face detection".
+	PublicModelFaceDetection = "a403429f2ddf4b49b307e318f00e528b"
+
+	// PublicModelDemographics is a public model "demographics", detecting faces with their age, gender and multicultural appearance.
+	PublicModelDemographics = "c0c0ac362b03416da06ab3fa36fb58e3"
+
+	// PublicModelApparel is a public model "apparel".
+	PublicModelApparel = "e0be3b9d6a454f0493ac3a30784001ff"
+
+	// PublicModelCelebrity is a public model "celebrity".
+	PublicModelCelebrity = "e466caa0619f444ab97497640cefc4dc"
+
+	// PublicModelLogo is a public model "logo".
+	PublicModelLogo = "c443119bf2ed4da98487520d01a0b1e3"
+
+	// PublicModelModeration is a public model "moderation".
+	PublicModelModeration = "d16f390eb32cad478c7ae150069bd2c6"
+
+	// PublicModelTextures is a public model "textures & patterns".
+	PublicModelTextures = "fbefb47f9fdb410e8ce14f24f54b47ff"
+
+	// PublicModelGeneralEmbedding is a public model "general embedding", returning embeddings instead of concepts.
+	PublicModelGeneralEmbedding = "bbb5f41425b8468d9b7a554ff10f8581"
+)
+
+// predictConcurrency is a maximum number of concurrent calls made by PredictMultipleModels.
+const predictConcurrency = 4
+
+const (
+	// Public models belong to this application of this user.
+	publicModelsUserID = "clarifai"
+	publicModelsAppID  = "main"
 )
 
 type ModelRequest struct {
@@ -36,6 +74,7 @@ type Model struct {
 	ID           *string       `json:"id,omitempty"`
 	CreatedAt    *string       `json:"created_at,omitempty"`
 	AppID        *string       `json:"app_id,omitempty"`
+	ModelTypeID  *string       `json:"model_type_id,omitempty"`
 	OutputInfo   *OutputInfo   `json:"output_info,omitempty"`
 	ModelVersion *ModelVersion `json:"model_version,omitempty"`
 }
@@ -51,11 +90,35 @@ type ModelVersion struct {
 	ID        string         `json:"id"`
 	CreatedAt string         `json:"created_at"`
 	Status    *ServiceStatus `json:"status"`
+	Metrics   *ModelMetrics  `json:"metrics,omitempty"`
 }
 
 type OutputConfig struct {
 	ConceptsMutuallyExclusive bool `json:"concepts_mutually_exclusive"`
 	ClosedEnvironment         bool `json:"closed_environment"`
+	PredictConfig
+}
+
+// PredictConfig holds output settings of predict calls, model settings of OutputConfig are never sent with them.
+type PredictConfig struct {
+	Language       string           `json:"language,omitempty"`        // Language of concept names in predictions.
+	MaxConcepts    int              `json:"max_concepts,omitempty"`    // Maximum number of concepts in predictions.
+	MinValue       float64          `json:"min_value,omitempty"`       // Minimum value of concepts in predictions.
+	SelectConcepts []*OutputConcept `json:"select_concepts,omitempty"` // Concepts to limit predictions to.
+	UseCache       *bool            `json:"use_cache,omitempty"`       // Predictions may be served from cache, sent only if set.
+}
+
+// predictRequest is a predict payload with an optional output configuration.
+type predictRequest struct {
+	Inputs []*Input      `json:"inputs"`
+	Model  *predictModel `json:"model,omitempty"`
+}
+
+// predictModel is a model object of a predict payload.
+type predictModel struct {
+	OutputInfo struct {
+		OutputConfig *PredictConfig `json:"output_config"`
+	} `json:"output_info"`
 }
 
 // modelOptions is a model configuration object used to set optional settings for a new model.
@@ -80,13 +143,171 @@ func NewModelOptions() *modelOptions {
 // Predict fetches prediction info for a provided asset from a given model.
 func (s *Session) Predict(i *Inputs) *Request {
 
-	r := NewRequest(s, http.MethodPost, "models/"+i.modelID+"/outputs")
-	r.SetPayload(i)
+	path := "models/" + i.Model() + "/outputs"
+	if i.modelVersionID != "" {
+		path = "models/" + i.Model() + "/versions/" + i.modelVersionID + "/outputs"
+	}
+
+	if i.publicModel {
+		path = "users/" + publicModelsUserID + "/apps/" + publicModelsAppID + "/" + path
+	}
+
+	r := NewRequest(s, http.MethodPost, path)
+
+	if i.outputConfig != nil {
+		m := &predictModel{}
+		m.OutputInfo.OutputConfig = i.outputConfig
+
+		r.SetPayload(&predictRequest{
+			Inputs: i.Inputs,
+			Model:  m,
+		})
+	} else {
+		r.SetPayload(i)
+	}
+	r.err = validateInputs(i.Inputs, false)
 
 	return r
 }
 
+// GetInputOutputs fetches predictions of a model stored for an input, without predicting it again.
+// Use UnmarshalPredict to parse the response. An empty model ID fetches outputs of all models.
+func (s *Session) GetInputOutputs(id, modelID string) *Request {
+
+	path := "inputs/" + id + "/outputs"
+	if modelID != "" {
+		v := url.Values{}
+		v.Set("model_id", modelID)
+		path += "?" + v.Encode()
+	}
+
+	return NewRequest(s, http.MethodGet, path)
+}
+
+// PredictImages builds inputs from images, sends a predict request to a given model and returns the parsed response.
+// Use Predict for fine-grained control over inputs.
+func (s *Session) PredictImages(modelID string, images ...*Image) (*PredictResponse, error) {
+
+	if len(images) > s.maxInputs() {
+		return nil, ErrInputLimitReached
+	}
+
+	i := s.InitInputs()
+	i.SetModel(modelID)
+	for _, im := range images {
+		if err := i.AddInput(im, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Predict(i).UnmarshalPredict()
+}
+
+// PredictMultipleModels predicts images with several models concurrently and returns responses keyed by model ID,
+// e.g. to get predictions of the color, general and moderation models without a workflow.
+// Failed models are returned in a MultiError, along with responses of the rest of models.
+func (s *Session) PredictMultipleModels(modelIDs []string, images ...*Image) (map[string]*PredictResponse, error) {
+
+	if len(images) > s.maxInputs() {
+		return nil, ErrInputLimitReached
+	}
+
+	// Skip duplicates of model IDs, each model is called once.
+	var ids []string
+	seen := map[string]struct{}{}
+	for _, id := range modelIDs {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	responses := make([]*PredictResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < predictConcurrency && w < len(ids); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				resp, err := s.PredictImages(ids[j], images...)
+				if err != nil {
+					errs[j] = fmt.Errorf("Model %s: %w", ids[j], err)
+				}
+				responses[j] = resp
+			}
+		}()
+	}
+
+	for j := range ids {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+
+	res := make(map[string]*PredictResponse, len(ids))
+	var multiErr MultiError
+
+	for j, id := range ids {
+		if responses[j] != nil {
+			res[id] = responses[j]
+		}
+		if errs[j] != nil {
+			multiErr = append(multiErr, errs[j])
+		}
+	}
+
+	if len(multiErr) > 0 {
+		return res, multiErr
+	}
+
+	return res, nil
+}
+
+// PredictBytes predicts raw image bytes with a given model in a single call.
+func (s *Session) PredictBytes(modelID string, images ...[]byte) (*PredictResponse, error) {
+
+	if len(images) > s.maxInputs() {
+		return nil, ErrInputLimitReached
+	}
+
+	ims := make([]*Image, len(images))
+	for j, b := range images {
+		if len(b) == 0 {
+			return nil, fmt.Errorf("Image %d: %w", j, ErrEmptyImageData)
+		}
+		ims[j] = NewImageFromBytes(b)
+	}
+
+	return s.PredictImages(modelID, ims...)
+}
+
+// PredictColors fetches dominant colors of images from the public color model.
+func (s *Session) PredictColors(images ...*Image) (*PredictResponse, error) {
+	return s.PredictImages(PublicModelColor, images...)
+}
+
+// Moderate predicts whether images are safe with the public moderation model, see Output.SafetyScore.
+func (s *Session) Moderate(images ...*Image) (*PredictResponse, error) {
+	return s.PredictImages(PublicModelModeration, images...)
+}
+
+// GetEmbeddings fetches embedding vectors of images from the public general embedding model.
+func (s *Session) GetEmbeddings(images ...*Image) (*PredictResponse, error) {
+	return s.PredictImages(PublicModelGeneralEmbedding, images...)
+}
+
+// DetectFaces detects faces in images with the public demographics model.
+// Each face is returned as an output region with a bounding box and age, gender and multicultural appearance concepts.
+func (s *Session) DetectFaces(images ...*Image) (*PredictResponse, error) {
+	return s.PredictImages(PublicModelDemographics, images...)
+}
+
 // CreateModel creates a new model. If ID is empty, it will be created automatically by Clarifai API.
+// Options may be nil to create a model with default settings.
 func (s *Session) CreateModel(name string, opt *modelOptions) *Request {
 
 	p := ModelRequest{
@@ -102,6 +323,10 @@ func (s *Session) CreateModel(name string, opt *modelOptions) *Request {
 		}
 	}
 
+	if opt == nil {
+		opt = NewModelOptions()
+	}
+
 	if opt.ID != "" {
 		p.Model.ID = &opt.ID
 	}
@@ -118,13 +343,8 @@ func (s *Session) CreateModel(name string, opt *modelOptions) *Request {
 		p.Model.OutputInfo.OutputData.Concepts = concepts
 	}
 
-	if opt.Concepts != nil {
-		p.Model.OutputInfo.OutputConfig.ConceptsMutuallyExclusive = opt.ConceptsMutuallyExclusive
-	}
-
-	if opt.Concepts != nil {
-		p.Model.OutputInfo.OutputConfig.ClosedEnvironment = opt.ClosedEnvironment
-	}
+	p.Model.OutputInfo.OutputConfig.ConceptsMutuallyExclusive = opt.ConceptsMutuallyExclusive
+	p.Model.OutputInfo.OutputConfig.ClosedEnvironment = opt.ClosedEnvironment
 
 	r := NewRequest(s, http.MethodPost, "models")
 	r.SetPayload(p)
@@ -229,6 +449,39 @@ func (s *Session) TrainModel(ID string) *Request {
 	return r
 }
 
+// WaitForModelTrained polls a model version every poll interval until its training is finished.
+// It returns the final version data, and an error wrapping ErrModelTrainingFailed if training has not succeeded.
+func (s *Session) WaitForModelTrained(ctx context.Context, modelID, versionID string, poll time.Duration) (*ModelVersion, error) {
+
+	for {
+		resp, err := s.GetModelVersion(modelID, versionID).DoContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		v := resp.ModelVersion
+		if v == nil || v.Status == nil {
+			return v, ErrNoModelVersionStatus
+		}
+
+		switch v.Status.Code {
+		case StatusModelTrained:
+			return v, nil
+		case StatusModelTraining, StatusModelQueued:
+		default:
+			return v, fmt.Errorf("%w: %d %s", ErrModelTrainingFailed, v.Status.Code, v.Status.Description)
+		}
+
+		t := time.NewTimer(poll)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return v, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
 // DeleteModelConcepts removes concepts from a model.
 func (s *Session) DeleteModelConcepts(ID string, c []string) *Request {
 
@@ -1,7 +1,16 @@
 package clarifai
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSession_Predict(t *testing.T) {
@@ -75,6 +84,330 @@ func TestSession_Predict(t *testing.T) {
 	CompareStructs(t, expected, resp)
 }
 
+func TestSession_Predict_Path(t *testing.T) {
+
+	i := InitInputs()
+
+	actual := sess.Predict(i).path
+	expected := "models/" + PublicModelGeneral + "/outputs"
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+
+	i.SetModelVersion(PublicModelGeneral, "foo")
+
+	actual = sess.Predict(i).path
+	expected = "models/" + PublicModelGeneral + "/versions/foo/outputs"
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
+
+func TestSession_Predict_PublicModel(t *testing.T) {
+
+	i := InitInputs()
+	i.SetPublicModel(PublicModelApparel)
+
+	actual := sess.Predict(i).path
+	expected := "users/clarifai/apps/main/models/" + PublicModelApparel + "/outputs"
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+
+	// A PAT session keeps the public model scope instead of its own application.
+	app := NewSessionWithPAT("test_pat", "foo", "bar")
+
+	actual = app.buildURI(app.Predict(i).path)
+	expected = app.host + "/" + apiVersion + "/users/clarifai/apps/main/models/" + PublicModelApparel + "/outputs"
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+
+	// Setting a custom model drops the public scope.
+	i.SetModel("custom")
+
+	actual = sess.Predict(i).path
+	expected = "models/custom/outputs"
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
+
+func TestSession_Predict_Language(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	err := i.SetLanguage("zh")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(sess.Predict(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}}],"model":{"output_info":{"output_config":{"language":"zh"}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	if err = i.SetLanguage("xx"); err != ErrUnsupportedLanguage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrUnsupportedLanguage)
+	}
+}
+
+func TestSession_Predict_MaxConceptsMinValue(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	if err := i.SetMaxConcepts(3); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	if err := i.SetMinValue(0.95); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(sess.Predict(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}}],"model":{"output_info":{"output_config":{"max_concepts":3,"min_value":0.95}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	if err = i.SetMaxConcepts(0); err != ErrInvalidMaxConcepts {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidMaxConcepts)
+	}
+	if err = i.SetMinValue(1.5); err != ErrInvalidMinValue {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidMinValue)
+	}
+	if err = i.SetMinValue(-0.1); err != ErrInvalidMinValue {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidMinValue)
+	}
+}
+
+func TestSession_Predict_UseCache(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	i.SetUseCache(false)
+
+	actual, err := json.Marshal(sess.Predict(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}}],"model":{"output_info":{"output_config":{"use_cache":false}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	i.SetUseCache(true)
+
+	actual, err = json.Marshal(sess.Predict(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"inputs":[{"data":{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}}],"model":{"output_info":{"output_config":{"use_cache":true}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_Predict_SelectConcepts(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	i.SelectConcepts([]string{"dog", "cat"})
+
+	actual, err := json.Marshal(sess.Predict(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}}],"model":{"output_info":{"output_config":{"select_concepts":[{"id":"dog"},{"id":"cat"}]}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	i.SelectConcepts([]string{})
+
+	actual, err = json.Marshal(sess.Predict(i).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"inputs":[{"data":{"image":{"url":"https://samples.clarifai.com/metro-north.jpg"}}}],"model":{"output_info":{"output_config":{}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	empty := InitInputs()
+	empty.SelectConcepts(nil)
+
+	actual, err = json.Marshal(sess.Predict(empty).payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected = `{"inputs":null}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_Predict_AmbiguousImage(t *testing.T) {
+
+	img := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+	img.Properties.Base64 = TestImageBase64
+
+	i := InitInputs()
+	_ = i.AddInput(img, "")
+
+	_, err := sess.Predict(i).Do()
+	if err != ErrAmbiguousImageData {
+		t.Errorf("Actual: %v, expected: %v", err, ErrAmbiguousImageData)
+	}
+}
+
+func TestSession_PredictImages(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/ok_predict_2img.json")
+
+	resp, err := sess.PredictImages(PublicModelGeneral,
+		NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"),
+		NewImageFromURL("https://samples.clarifai.com/wedding.jpg"),
+	)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Outputs) != 2 {
+		t.Errorf("Actual: %v, expected: %v", len(resp.Outputs), 2)
+	}
+}
+
+func TestSession_PredictImages_Limit(t *testing.T) {
+
+	images := make([]*Image, InputLimit+1)
+	for j := range images {
+		images[j] = NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+	}
+
+	// No route is mocked: the limit must be checked before any network call.
+	_, err := sess.PredictImages(PublicModelGeneral, images...)
+	if err != ErrInputLimitReached {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInputLimitReached)
+	}
+}
+
+func TestSession_GetInputOutputs(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/foo/outputs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("model_id") != PublicModelGeneral {
+			t.Errorf("Actual: %v, expected: %v", r.URL.Query().Get("model_id"), PublicModelGeneral)
+		}
+
+		printMock(t, w, "resp/ok_predict_1img.json")
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	resp, err := sess.GetInputOutputs("foo", PublicModelGeneral).UnmarshalPredict()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Outputs) != 1 || resp.Outputs[0].Data.Concepts[0].Name != "train" {
+		t.Errorf("Actual: %+v, expected an output with concept %v", resp.Outputs, "train")
+	}
+
+	if r := sess.GetInputOutputs("foo", ""); r.path != "inputs/foo/outputs" {
+		t.Errorf("Actual: %v, expected: %v", r.path, "inputs/foo/outputs")
+	}
+}
+
+func TestSession_PredictMultipleModels(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/ok_predict_1img.json")
+	mockRoute(t, "models/"+PublicModelColor+"/outputs", "resp/ok_10000_predict_color.json")
+	mockRoute(t, "models/"+PublicModelModeration+"/outputs", "resp/fail_11100_bad_req_supply_inputs.json")
+
+	res, err := sess.PredictMultipleModels(
+		[]string{PublicModelGeneral, PublicModelColor, PublicModelModeration, PublicModelGeneral},
+		NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"),
+	)
+
+	errs, ok := err.(MultiError)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Actual: %v, expected a MultiError of 1 error", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(errs[0], &apiErr) || !strings.HasPrefix(errs[0].Error(), "Model "+PublicModelModeration+": ") {
+		t.Errorf("Actual: %v, expected an *APIError of model %v", errs[0], PublicModelModeration)
+	}
+
+	if len(res) != 3 {
+		t.Fatalf("Actual: %v, expected: %v", len(res), 3)
+	}
+
+	if res[PublicModelGeneral].Outputs[0].Data.Concepts[0].Name != "train" {
+		t.Errorf("Actual: %+v, expected a general model response", res[PublicModelGeneral])
+	}
+
+	if len(res[PublicModelColor].Outputs[0].Data.Colors) == 0 {
+		t.Errorf("Actual: %+v, expected a color model response", res[PublicModelColor])
+	}
+}
+
+func TestSession_PredictBytes(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/models/"+PublicModelGeneral+"/outputs", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		expected := base64.StdEncoding.EncodeToString([]byte("foo"))
+		if !strings.Contains(string(body), `"base64":"`+expected+`"`) {
+			t.Errorf("Actual: %s, expected a base64 encoded image %v", body, expected)
+		}
+
+		printMock(t, w, "resp/ok_predict_2img.json")
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	resp, err := sess.PredictBytes(PublicModelGeneral, []byte("foo"), []byte("bar"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Outputs) != 2 {
+		t.Errorf("Actual: %v, expected: %v", len(resp.Outputs), 2)
+	}
+}
+
+func TestSession_PredictBytes_Empty(t *testing.T) {
+
+	_, err := sess.PredictBytes(PublicModelGeneral, []byte("foo"), nil)
+	if !errors.Is(err, ErrEmptyImageData) {
+		t.Errorf("Actual: %v, expected: %v", err, ErrEmptyImageData)
+	}
+
+	if err.Error() != "Image 1: "+ErrEmptyImageData.Error() {
+		t.Errorf("Actual: %v, expected: %v", err, "Image 1: "+ErrEmptyImageData.Error())
+	}
+}
+
 func TestSession_CreateModel(t *testing.T) {
 
 	mockRoute(t, "models", "resp/ok_10000_create_model.json")
@@ -119,6 +452,40 @@ func TestSession_CreateModel(t *testing.T) {
 	CompareStructs(t, expected, resp)
 }
 
+func TestSession_CreateModel_Options(t *testing.T) {
+
+	opt := NewModelOptions()
+	opt.ID = "test-id-1"
+	opt.Concepts = []string{"foo"}
+	opt.ConceptsMutuallyExclusive = true
+
+	p := sess.CreateModel("test-model", opt).payload.(ModelRequest)
+
+	actual, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"model":{"name":"test-model","id":"test-id-1","output_info":{"output_config":{"concepts_mutually_exclusive":true,"closed_environment":false},"data":{"concepts":[{"id":"foo"}]}}}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	// Output config applies to models created without concepts too.
+	opt = NewModelOptions()
+	opt.ClosedEnvironment = true
+
+	p = sess.CreateModel("test-model", opt).payload.(ModelRequest)
+	if !p.Model.OutputInfo.OutputConfig.ClosedEnvironment {
+		t.Error("ClosedEnvironment should be set")
+	}
+
+	p = sess.CreateModel("test-model", nil).payload.(ModelRequest)
+	if p.Model.ID != nil {
+		t.Errorf("Actual: %v, expected: %v", p.Model.ID, nil)
+	}
+}
+
 func TestSession_GetModels(t *testing.T) {
 
 	serverReset()
@@ -521,6 +888,47 @@ func TestSession_TrainModel(t *testing.T) {
 	CompareStructs(t, expected, resp)
 }
 
+func TestSession_WaitForModelTrained(t *testing.T) {
+
+	serverReset()
+
+	statuses := []int{21103, 21101, 21100}
+	calls := 0
+	mux.HandleFunc("/"+apiVersion+"/models/foo/versions/bar", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status":{"code":10000,"description":"Ok"},"model_version":{"id":"bar","status":{"code":%d}}}`, statuses[calls])
+		calls++
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	v, err := sess.WaitForModelTrained(context.Background(), "foo", "bar", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if v.Status.Code != 21100 {
+		t.Errorf("Actual: %v, expected: %v", v.Status.Code, 21100)
+	}
+
+	if calls != 3 {
+		t.Errorf("Actual: %v, expected: %v", calls, 3)
+	}
+}
+
+func TestSession_WaitForModelTrained_Failed(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/foo/versions/bar", "resp/ok_10000_get_model_version.json")
+
+	v, err := sess.WaitForModelTrained(context.Background(), "foo", "bar", time.Millisecond)
+	if !errors.Is(err, ErrModelTrainingFailed) {
+		t.Fatalf("Actual: %v, expected: %v", err, ErrModelTrainingFailed)
+	}
+
+	if v.Status.Code != 21111 {
+		t.Errorf("Actual: %v, expected: %v", v.Status.Code, 21111)
+	}
+}
+
 func TestSession_DeleteModelConcepts(t *testing.T) {
 
 	serverReset()
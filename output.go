@@ -1,5 +1,10 @@
 package clarifai
 
+import (
+	"sort"
+	"strings"
+)
+
 // Output query fragment.
 type Output struct {
 	ID        string         `json:"id"`
@@ -11,9 +16,15 @@ type Output struct {
 }
 
 type OutputData struct {
-	Concepts []*OutputConcept `json:"concepts,omitempty"`
-	Image    *ImageData       `json:"image,omitempty"`
-	Metadata *interface{}     `json:"metadata,omitempty"`
+	Concepts   []*OutputConcept `json:"concepts,omitempty"`
+	Image      *ImageData       `json:"image,omitempty"`
+	Text       *Text            `json:"text,omitempty"` // Output of text-to-text models.
+	Metadata   *interface{}     `json:"metadata,omitempty"`
+	Regions    []*Region        `json:"regions,omitempty"`    // Returned by detection models.
+	Frames     []*Frame         `json:"frames,omitempty"`     // Returned for video inputs.
+	Colors     []*Color         `json:"colors,omitempty"`     // Returned by the color model.
+	Embeddings []*Embedding     `json:"embeddings,omitempty"` // Returned by embedding models.
+	Clusters   []*Cluster       `json:"clusters,omitempty"`   // Returned by cluster models.
 }
 
 type OutputConcept struct {
@@ -23,4 +34,124 @@ type OutputConcept struct {
 	Name      string  `json:"name,omitempty"`
 	UpdatedAt string  `json:"updated_at,omitempty"`
 	Value     float64 `json:"value,omitempty"`
+	Language  string  `json:"language,omitempty"`
+}
+
+// Region is an area of an image detected by a detection model.
+type Region struct {
+	ID         string      `json:"id"`
+	RegionInfo *RegionInfo `json:"region_info,omitempty"`
+	Data       *RegionData `json:"data,omitempty"`
+}
+
+type RegionInfo struct {
+	BoundingBox *BoundingBox `json:"bounding_box,omitempty"`
+}
+
+// BoundingBox holds region coordinates as fractions of the image height (rows) and width (columns).
+type BoundingBox struct {
+	TopRow    float64 `json:"top_row"`
+	LeftCol   float64 `json:"left_col"`
+	BottomRow float64 `json:"bottom_row"`
+	RightCol  float64 `json:"right_col"`
+}
+
+type RegionData struct {
+	Concepts []*OutputConcept `json:"concepts,omitempty"`
+	Face     *Face            `json:"face,omitempty"` // Returned by the demographics model.
+}
+
+// Face holds demographic appearance concepts of a detected face.
+type Face struct {
+	AgeAppearance           *FaceConcepts `json:"age_appearance,omitempty"`
+	GenderAppearance        *FaceConcepts `json:"gender_appearance,omitempty"`
+	MulticulturalAppearance *FaceConcepts `json:"multicultural_appearance,omitempty"`
+}
+
+type FaceConcepts struct {
+	Concepts []*OutputConcept `json:"concepts,omitempty"`
+}
+
+// Color is a dominant color of an image as returned by the color model.
+type Color struct {
+	Hex   string    `json:"raw_hex"`
+	Value float64   `json:"value"` // Share of the image covered by the color.
+	W3C   *W3CColor `json:"w3c,omitempty"`
+}
+
+// W3CColor is the closest W3C named color.
+type W3CColor struct {
+	Hex  string `json:"hex"`
+	Name string `json:"name"`
+}
+
+// Names of concepts of the moderation and NSFW models, in order of preference.
+var (
+	safeConceptNames     = []string{"safe", "sfw"}
+	explicitConceptNames = []string{"explicit", "nsfw"}
+)
+
+// SafetyScore returns values of the safe and explicit concepts of an output of the moderation model.
+// Concepts of the NSFW model, "sfw" and "nsfw", are recognized as well. A value of a missing concept is 0.
+func (o *Output) SafetyScore() (safe, explicit float64) {
+
+	if o.Data == nil {
+		return 0, 0
+	}
+
+	return conceptValue(o.Data.Concepts, safeConceptNames), conceptValue(o.Data.Concepts, explicitConceptNames)
+}
+
+// conceptValue returns a value of the first concept found by one of the names, matching either its name or ID.
+func conceptValue(concepts []*OutputConcept, names []string) float64 {
+
+	for _, name := range names {
+		for _, c := range concepts {
+			if strings.EqualFold(c.Name, name) || strings.EqualFold(c.ID, name) {
+				return c.Value
+			}
+		}
+	}
+
+	return 0
+}
+
+// Embedding is a vector representation of an input as returned by embedding models.
+type Embedding struct {
+	Vector        []float32 `json:"vector"`
+	NumDimensions int       `json:"num_dimensions"`
+}
+
+// FilterConcepts returns a copy of concepts of the output with a value of at least min. The output is left intact.
+func (o *Output) FilterConcepts(min float64) []*OutputConcept {
+
+	if o.Data == nil {
+		return nil
+	}
+
+	concepts := make([]*OutputConcept, 0, len(o.Data.Concepts))
+	for _, c := range o.Data.Concepts {
+		if c.Value >= min {
+			concepts = append(concepts, c)
+		}
+	}
+
+	return concepts
+}
+
+// SortedConcepts returns a copy of concepts of the output sorted by value in descending order. The output is left intact.
+func (o *Output) SortedConcepts() []*OutputConcept {
+
+	if o.Data == nil {
+		return nil
+	}
+
+	concepts := make([]*OutputConcept, len(o.Data.Concepts))
+	copy(concepts, o.Data.Concepts)
+
+	sort.SliceStable(concepts, func(i, j int) bool {
+		return concepts[i].Value > concepts[j].Value
+	})
+
+	return concepts
 }
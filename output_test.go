@@ -0,0 +1,245 @@
+package clarifai
+
+import (
+	"testing"
+)
+
+func TestOutput_Regions(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelFaceDetection+"/outputs", "resp/ok_10000_predict_face_detection.json")
+
+	resp, err := sess.PredictImages(PublicModelFaceDetection, NewImageFromURL("https://samples.clarifai.com/face-det.jpg"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := []*Region{
+		{
+			ID: "yt3anpbb5iod",
+			RegionInfo: &RegionInfo{
+				BoundingBox: &BoundingBox{
+					TopRow:    0.2302527,
+					LeftCol:   0.30972016,
+					BottomRow: 0.48951283,
+					RightCol:  0.5018514,
+				},
+			},
+			Data: &RegionData{
+				Concepts: []*OutputConcept{
+					{
+						ID:    "ai_b1b1b1b1",
+						Name:  "face",
+						Value: 0.99953,
+					},
+				},
+			},
+		},
+		{
+			ID: "u1xklvdeodsd",
+			RegionInfo: &RegionInfo{
+				BoundingBox: &BoundingBox{
+					TopRow:    0.3158887,
+					LeftCol:   0.6042129,
+					BottomRow: 0.57250637,
+					RightCol:  0.8016372,
+				},
+			},
+		},
+	}
+
+	CompareStructs(t, expected, resp.Outputs[0].Data.Regions)
+}
+
+func TestOutput_Colors(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelColor+"/outputs", "resp/ok_10000_predict_color.json")
+
+	resp, err := sess.PredictColors(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := []*Color{
+		{
+			Hex:   "#f2f2f2",
+			Value: 0.929,
+			W3C: &W3CColor{
+				Hex:  "#f5f5f5",
+				Name: "WhiteSmoke",
+			},
+		},
+		{
+			Hex:   "#686078",
+			Value: 0.02675,
+			W3C: &W3CColor{
+				Hex:  "#708090",
+				Name: "SlateGray",
+			},
+		},
+	}
+
+	CompareStructs(t, expected, resp.Outputs[0].Data.Colors)
+}
+
+func TestOutput_Faces(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelDemographics+"/outputs", "resp/ok_10000_predict_demographics.json")
+
+	resp, err := sess.DetectFaces(NewImageFromURL("https://samples.clarifai.com/demographics.jpg"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	r := resp.Outputs[0].Data.Regions[0]
+
+	expectedBox := &BoundingBox{
+		TopRow:    0.1308962,
+		LeftCol:   0.3753129,
+		BottomRow: 0.4463416,
+		RightCol:  0.6058444,
+	}
+	CompareStructs(t, expectedBox, r.RegionInfo.BoundingBox)
+
+	f := r.Data.Face
+	if f.AgeAppearance.Concepts[0].Name != "23" {
+		t.Errorf("Actual: %v, expected: %v", f.AgeAppearance.Concepts[0].Name, "23")
+	}
+
+	if f.GenderAppearance.Concepts[0].Name != "feminine" {
+		t.Errorf("Actual: %v, expected: %v", f.GenderAppearance.Concepts[0].Name, "feminine")
+	}
+
+	if f.MulticulturalAppearance.Concepts[0].Name != "white" {
+		t.Errorf("Actual: %v, expected: %v", f.MulticulturalAppearance.Concepts[0].Name, "white")
+	}
+}
+
+func TestOutput_Embeddings(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneralEmbedding+"/outputs", "resp/ok_10000_predict_embedding.json")
+
+	resp, err := sess.GetEmbeddings(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	expected := []*Embedding{
+		{
+			Vector:        []float32{-0.0161084, 0.0323552, 0.0006472, 0.0078236},
+			NumDimensions: 4,
+		},
+	}
+
+	CompareStructs(t, expected, resp.Outputs[0].Data.Embeddings)
+}
+
+func TestOutput_SafetyScore(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelModeration+"/outputs", "resp/ok_10000_predict_moderation.json")
+
+	resp, err := sess.Moderate(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"))
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	safe, explicit := resp.Outputs[0].SafetyScore()
+	if safe != 0.9981 || explicit != 0.0002 {
+		t.Errorf("Actual: %v and %v, expected: %v and %v", safe, explicit, 0.9981, 0.0002)
+	}
+
+	// Concepts of the NSFW model.
+	o := &Output{
+		Data: &OutputData{
+			Concepts: []*OutputConcept{
+				{ID: "ai_RT20lm2Q", Name: "NSFW", Value: 0.8},
+				{ID: "ai_KxzHKtPl", Name: "SFW", Value: 0.2},
+			},
+		},
+	}
+
+	safe, explicit = o.SafetyScore()
+	if safe != 0.2 || explicit != 0.8 {
+		t.Errorf("Actual: %v and %v, expected: %v and %v", safe, explicit, 0.2, 0.8)
+	}
+
+	// Unknown concepts and missing data.
+	o = &Output{
+		Data: &OutputData{
+			Concepts: []*OutputConcept{{ID: "train", Value: 0.9}},
+		},
+	}
+
+	if safe, explicit = o.SafetyScore(); safe != 0 || explicit != 0 {
+		t.Errorf("Actual: %v and %v, expected: %v and %v", safe, explicit, 0, 0)
+	}
+
+	if safe, explicit = (&Output{}).SafetyScore(); safe != 0 || explicit != 0 {
+		t.Errorf("Actual: %v and %v, expected: %v and %v", safe, explicit, 0, 0)
+	}
+}
+
+func TestOutput_FilterConcepts(t *testing.T) {
+
+	o := &Output{
+		Data: &OutputData{
+			Concepts: []*OutputConcept{
+				{ID: "train", Value: 0.99},
+				{ID: "railway", Value: 0.5},
+				{ID: "people", Value: 0.1},
+			},
+		},
+	}
+
+	actual := o.FilterConcepts(0.5)
+	expected := []*OutputConcept{
+		{ID: "train", Value: 0.99},
+		{ID: "railway", Value: 0.5},
+	}
+
+	CompareStructs(t, expected, actual)
+
+	if len(o.Data.Concepts) != 3 {
+		t.Errorf("Actual: %v, expected: %v", len(o.Data.Concepts), 3)
+	}
+
+	if (&Output{}).FilterConcepts(0.5) != nil {
+		t.Error("Output without data should have no concepts")
+	}
+}
+
+func TestOutput_SortedConcepts(t *testing.T) {
+
+	o := &Output{
+		Data: &OutputData{
+			Concepts: []*OutputConcept{
+				{ID: "people", Value: 0.1},
+				{ID: "train", Value: 0.99},
+				{ID: "railway", Value: 0.5},
+				{ID: "station", Value: 0.5},
+			},
+		},
+	}
+
+	actual := o.SortedConcepts()
+	expected := []*OutputConcept{
+		{ID: "train", Value: 0.99},
+		{ID: "railway", Value: 0.5},
+		{ID: "station", Value: 0.5},
+		{ID: "people", Value: 0.1},
+	}
+
+	CompareStructs(t, expected, actual)
+
+	if o.Data.Concepts[0].ID != "people" {
+		t.Errorf("Actual: %v, expected: %v", o.Data.Concepts[0].ID, "people")
+	}
+
+	if (&Output{}).SortedConcepts() != nil {
+		t.Error("Output without data should have no concepts")
+	}
+}
@@ -0,0 +1,16 @@
+package clarifai
+
+import "context"
+
+// RateLimiter delays API calls to keep their rate within a limit.
+// It is satisfied by *rate.Limiter of golang.org/x/time/rate.
+type RateLimiter interface {
+	// Wait blocks until a call is allowed or the context is done.
+	Wait(ctx context.Context) error
+}
+
+// SetRateLimiter makes every API call of the session, including retries, wait on the limiter before it is sent.
+// A nil limiter disables rate limiting, which is the default.
+func (s *Session) SetRateLimiter(l RateLimiter) {
+	s.limiter = l
+}
@@ -0,0 +1,69 @@
+package clarifai
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// mockLimiter counts waits and fails them once the context is done.
+type mockLimiter struct {
+	waits int
+}
+
+func (l *mockLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return ctx.Err()
+}
+
+func TestSession_SetRateLimiter(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/limited", func(w http.ResponseWriter, r *http.Request) {
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	l := &mockLimiter{}
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetRateLimiter(l)
+
+	for i := 0; i < 2; i++ {
+		if _, err := app.HTTPCall(http.MethodGet, "inputs/limited", nil); err != nil {
+			t.Fatalf("Should have no errors, but got %v", err)
+		}
+	}
+
+	if l.waits != 2 {
+		t.Errorf("Actual: %v, expected: %v", l.waits, 2)
+	}
+}
+
+func TestSession_SetRateLimiter_Cancel(t *testing.T) {
+
+	serverReset()
+
+	calls := 0
+	mux.HandleFunc("/"+apiVersion+"/inputs/limited", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetRateLimiter(&mockLimiter{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := app.HTTPCallContext(ctx, http.MethodGet, "inputs/limited", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Actual: %v, expected: %v", err, context.Canceled)
+	}
+
+	if calls != 0 {
+		t.Errorf("Actual: %v, expected: %v", calls, 0)
+	}
+}
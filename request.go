@@ -1,26 +1,41 @@
 package clarifai
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
 	defaultPage            = 1
 	defaultItemsPerPageQty = 20
+	maxItemsPerPageQty     = 1000
 )
 
 // Request contains all information necessary to create an HTTP request to Clarifai API.
 type Request struct {
-	method  string
-	page    int
-	perPage int
-	path    string
-	payload interface{}
-	session *Session
+	method    string
+	page      int
+	perPage   int
+	path      string
+	payload   interface{}
+	session   *Session
+	err       error         // Set by request builders on invalid arguments and returned by Do.
+	timeout   time.Duration // Optional deadline of a single call.
+	requestID string        // Optional ID sent in the request ID header.
+	headers   http.Header   // Custom headers of the request.
 }
 
+// requestIDHeader is a header correlating a call with API logs, e.g. in support cases.
+const requestIDHeader = "X-Clarifai-Request-ID"
+
 // NewRequest generates a new Request object with default settings.
 func NewRequest(s *Session, method, path string) *Request {
 	return &Request{
@@ -39,6 +54,29 @@ func (r *Request) SetPayload(p interface{}) {
 	r.payload = p
 }
 
+// SetTimeout sets a deadline of the request, applied on top of a timeout of the HTTP client, if any.
+func (r *Request) SetTimeout(d time.Duration) {
+	r.timeout = d
+}
+
+// SetHeader sets a custom header of the request, overriding a default header of the session.
+func (r *Request) SetHeader(key, value string) {
+	if r.headers == nil {
+		r.headers = http.Header{}
+	}
+	r.headers.Set(key, value)
+}
+
+// SetRequestID sets an ID sent to API in the X-Clarifai-Request-ID header.
+func (r *Request) SetRequestID(id string) {
+	r.requestID = id
+}
+
+// RequestID returns an ID of the request set by SetRequestID or generated on a call, see Session.SetAutoRequestID.
+func (r *Request) RequestID() string {
+	return r.requestID
+}
+
 // WithPagination adds pagination configuration to the request.
 func (r *Request) WithPagination(page, perPage int) *Request {
 	r.page = page
@@ -49,46 +87,182 @@ func (r *Request) WithPagination(page, perPage int) *Request {
 
 // Do sends a request to API.
 func (r *Request) Do() (*Response, error) {
+	return r.DoContext(context.Background())
+}
+
+// DoContext sends a request to API. Cancelling the context aborts an in-flight request.
+func (r *Request) DoContext(ctx context.Context) (*Response, error) {
+
+	resp, _, err := r.do(ctx)
+	return resp, err
+}
 
-	var resp *Response
+// DoInto sends a request to API and unmarshals the response body into v, e.g. a custom response type.
+// If the API reports a failure, v is still filled from the body, and an *APIError is returned.
+func (r *Request) DoInto(ctx context.Context, v interface{}) error {
+
+	_, body, err := r.do(ctx)
+	if body == nil {
+		return err
+	}
+
+	if jsonErr := json.Unmarshal(body, v); err == nil {
+		err = jsonErr
+	}
+
+	return err
+}
+
+// do sends a request to API and returns its parsed and raw response body.
+func (r *Request) do(ctx context.Context) (*Response, []byte, error) {
+
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	req, err := r.BuildContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.session.send(req)
+}
+
+// PayloadJSON returns the JSON body of the request as it would be sent, e.g. to compare it with a golden file in tests.
+// A request without a payload returns nil.
+func (r *Request) PayloadJSON() ([]byte, error) {
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	// Sets pagination of payloads carrying it in the body.
+	r.addPagination()
+
+	if r.payload == nil {
+		return nil, nil
+	}
+
+	if st, ok := r.payload.(streamer); ok {
+		var b bytes.Buffer
+		if err := st.stream(&b); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+
+	return json.Marshal(r.payload)
+}
+
+// Build returns an HTTP request ready to be sent to API, e.g. to log or inspect it.
+// A session authenticated by client credentials may connect to API first to obtain an access token.
+func (r *Request) Build() (*http.Request, error) {
+	return r.BuildContext(context.Background())
+}
+
+// BuildContext is like Build, but the HTTP request is bound to the provided context.
+func (r *Request) BuildContext(ctx context.Context) (*http.Request, error) {
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var req *http.Request
 	var err error
 
 	switch r.method {
 	case http.MethodGet:
-		r.addPagination()
-		resp, err = r.session.HTTPCall(r.method, r.path, nil)
+		req, err = r.session.newHTTPRequest(ctx, r.method, r.addPagination(), nil)
 	case http.MethodPost, http.MethodPatch, http.MethodDelete:
-		r.addPagination()
-		resp, err = r.session.HTTPCall(r.method, r.path, r.payload)
+		req, err = r.session.newHTTPRequest(ctx, r.method, r.addPagination(), r.payload)
 	default:
 		panic("Unsupported HTTP method!")
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return resp, err
+	if r.requestID == "" && r.session.autoRequestID {
+		r.requestID, err = newRequestID()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.requestID != "" {
+		req.Header.Set(requestIDHeader, r.requestID)
+	}
+
+	for k, v := range r.headers {
+		req.Header[k] = v
+	}
+
+	return req, nil
+}
+
+// newRequestID generates a random (version 4) UUID.
+func newRequestID() (string, error) {
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// validatePagination checks pagination arguments against the limits of the API.
+func validatePagination(page, perPage int) error {
+
+	if page < 1 {
+		return ErrInvalidPage
+	}
+
+	if perPage < 1 || perPage > maxItemsPerPageQty {
+		return ErrInvalidPerPage
+	}
+
+	return nil
 }
 
-// addPagination adds pagination arguments to endpoint path.
-func (r *Request) addPagination() {
+// bodyPaginator is implemented by payloads carrying pagination in a request body.
+type bodyPaginator interface {
+	setPagination(p *pagination)
+}
 
-	if r.page > 0 && r.perPage > 0 {
+// addPagination returns an endpoint path with pagination arguments.
+// Requests with pagination in their body get it set to the payload instead, and their path is returned as is.
+func (r *Request) addPagination() string {
 
-		// Some actions add pagination as part of their request body.
-		if r.method == http.MethodPost {
-			p, ok := r.payload.(*SearchRequest) // for /searches
-			if ok {
-				p.Pagination = &pagination{
-					Page:    r.page,
-					PerPage: r.perPage,
-				}
-				r.payload = p
-			}
+	if r.page <= 0 || r.perPage <= 0 {
+		return r.path
+	}
 
-		} else {
-			v := url.Values{}
-			v.Set("page", strconv.Itoa(r.page))
-			v.Add("per_page", strconv.Itoa(r.perPage))
-			r.path += "?" + v.Encode()
+	// Some actions add pagination as part of their request body.
+	if r.method == http.MethodPost {
+		p, ok := r.payload.(bodyPaginator) // for /searches
+		if ok {
+			p.setPagination(&pagination{
+				Page:    r.page,
+				PerPage: r.perPage,
+			})
 		}
+
+		return r.path
+	}
+
+	v := url.Values{}
+	v.Set("page", strconv.Itoa(r.page))
+	v.Add("per_page", strconv.Itoa(r.perPage))
+
+	if strings.Contains(r.path, "?") {
+		return r.path + "&" + v.Encode()
 	}
 
+	return r.path + "?" + v.Encode()
 }
@@ -1,9 +1,15 @@
 package clarifai
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewRequest(t *testing.T) {
@@ -55,12 +61,22 @@ func TestRequest_addPagination_Get(t *testing.T) {
 
 	r := NewRequest(sess, http.MethodGet, "foo")
 	r.WithPagination(1, 5)
-	r.addPagination()
+	path := r.addPagination()
 
 	expected := "foo?page=1&per_page=5"
 
-	if r.path != expected {
-		t.Errorf("Actual: %v, expected: %v", r.path, expected)
+	if path != expected {
+		t.Errorf("Actual: %v, expected: %v", path, expected)
+	}
+
+	// Pagination is not added to the request path, so repeated calls give the same result.
+	if path = r.addPagination(); path != expected {
+		t.Errorf("Actual: %v, expected: %v", path, expected)
+	}
+
+	r = NewRequest(sess, http.MethodGet, "foo?bar=baz")
+	if path = r.addPagination(); path != "foo?bar=baz&page=1&per_page=20" {
+		t.Errorf("Actual: %v, expected: %v", path, "foo?bar=baz&page=1&per_page=20")
 	}
 }
 
@@ -91,3 +107,238 @@ func TestRequest_addPagination_Post(t *testing.T) {
 		t.Errorf("Actual: %v, expected: %v", p.Pagination.PerPage, 5)
 	}
 }
+
+func TestRequest_DoContext_Cancelled(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_inputs.json")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := sess.GetAllInputs().DoContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Actual: %v, expected: %v", err, context.Canceled)
+	}
+}
+
+func TestRequest_SetTimeout(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	r := NewRequest(sess, http.MethodGet, "inputs/slow")
+	r.SetTimeout(10 * time.Millisecond)
+
+	_, err := r.Do()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Actual: %v, expected: %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRequest_Build(t *testing.T) {
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL("https://example.com")
+
+	r := app.SearchConcepts("ca", "")
+	r.WithPagination(2, 10)
+
+	req, err := r.Build()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if req.Method != http.MethodPost {
+		t.Errorf("Actual: %v, expected: %v", req.Method, http.MethodPost)
+	}
+
+	if req.URL.String() != "https://example.com/v2/concepts/searches" {
+		t.Errorf("Actual: %v, expected: %v", req.URL.String(), "https://example.com/v2/concepts/searches")
+	}
+
+	if req.Header.Get("Authorization") != "Key test_api_key" {
+		t.Errorf("Actual: %v, expected: %v", req.Header.Get("Authorization"), "Key test_api_key")
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if !strings.Contains(string(body), `"pagination":{"page":2,"per_page":10}`) {
+		t.Errorf("Actual: %v, expected a body with pagination", string(body))
+	}
+}
+
+func TestRequest_Build_Invalid(t *testing.T) {
+
+	_, err := sess.GetAllInputsWithPagination(0, 10).Build()
+	if err != ErrInvalidPage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidPage)
+	}
+}
+
+func TestRequest_PayloadJSON(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddInput(NewImageFromURL("https://s-media-cache-ak0.pinimg.com/564x/be/20/f1/be20f1305c77aba6eddfcfbb9fae585d.jpg"), "")
+
+	actual, err := sess.Predict(i).PayloadJSON()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	golden, err := ioutil.ReadFile("mocks/req/ok_predict_1img.json")
+	if err != nil {
+		t.Fatalf("Error reading a mock file: %v", err)
+	}
+
+	var a, e interface{}
+	_ = json.Unmarshal(actual, &a)
+	_ = json.Unmarshal(golden, &e)
+
+	if !reflect.DeepEqual(a, e) {
+		t.Errorf("Actual: %s, expected: %s", actual, golden)
+	}
+
+	// Pagination is part of search payloads.
+	actual, err = sess.SearchConcepts("ca", "").WithPagination(2, 10).PayloadJSON()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if !strings.Contains(string(actual), `"pagination":{"page":2,"per_page":10}`) {
+		t.Errorf("Actual: %s, expected a body with pagination", actual)
+	}
+
+	if actual, err = sess.GetAllInputs().PayloadJSON(); actual != nil || err != nil {
+		t.Errorf("Actual: %s and %v, expected: %v and %v", actual, err, nil, nil)
+	}
+
+	if _, err = sess.GetAllInputsWithPagination(0, 10).PayloadJSON(); err != ErrInvalidPage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidPage)
+	}
+}
+
+func TestRequest_SetRequestID(t *testing.T) {
+
+	app := NewApp("test_api_key")
+
+	r := app.GetAllInputs()
+
+	req, err := r.Build()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if _, ok := req.Header[http.CanonicalHeaderKey(requestIDHeader)]; ok {
+		t.Errorf("Header %v should not be set", requestIDHeader)
+	}
+
+	r.SetRequestID("foo")
+
+	req, err = r.Build()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if req.Header.Get(requestIDHeader) != "foo" {
+		t.Errorf("Actual: %v, expected: %v", req.Header.Get(requestIDHeader), "foo")
+	}
+}
+
+func TestSession_SetAutoRequestID(t *testing.T) {
+
+	app := NewApp("test_api_key")
+	app.SetAutoRequestID(true)
+
+	r := app.GetAllInputs()
+
+	req, err := r.Build()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	id := req.Header.Get(requestIDHeader)
+	if len(id) != 36 || id != r.RequestID() {
+		t.Errorf("Actual: %v, expected a UUID equal to %v", id, r.RequestID())
+	}
+
+	if other := app.GetAllInputs(); other.RequestID() != "" {
+		t.Errorf("Actual: %v, expected an empty ID before a call", other.RequestID())
+	}
+}
+
+func TestRequest_DoInto(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/foo/clusters", "resp/ok_10000_get_input_clusters.json")
+
+	var v struct {
+		Clusters []struct {
+			ID string `json:"id"`
+		} `json:"clusters"`
+	}
+
+	err := sess.GetInputClusters("foo").DoInto(context.Background(), &v)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(v.Clusters) != 1 || v.Clusters[0].ID != "cluster-1" {
+		t.Errorf("Actual: %+v, expected cluster %v", v.Clusters, "cluster-1")
+	}
+}
+
+func TestRequest_DoInto_Fail(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/foo/clusters", "resp/fail_11100_bad_req_invalid_json.json")
+
+	var v Response
+
+	err := sess.GetInputClusters("foo").DoInto(context.Background(), &v)
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	if v.Status == nil || v.Status.Code != apiErr.Code {
+		t.Errorf("Actual: %+v, expected status %v", v.Status, apiErr.Code)
+	}
+}
+
+func TestRequest_SetHeader(t *testing.T) {
+
+	app := NewApp("test_api_key")
+	app.SetDefaultHeader("X-Gateway-Key", "foo")
+	app.SetDefaultHeader("X-Team", "bar")
+
+	r := app.GetAllInputs()
+	r.SetHeader("X-Team", "baz")
+
+	req, err := r.Build()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if req.Header.Get("X-Gateway-Key") != "foo" {
+		t.Errorf("Actual: %v, expected: %v", req.Header.Get("X-Gateway-Key"), "foo")
+	}
+
+	if req.Header.Get("X-Team") != "baz" {
+		t.Errorf("Actual: %v, expected: %v", req.Header.Get("X-Team"), "baz")
+	}
+
+	if req.Header.Get("Authorization") != "Key test_api_key" {
+		t.Errorf("Actual: %v, expected: %v", req.Header.Get("Authorization"), "Key test_api_key")
+	}
+}
@@ -1,14 +1,254 @@
 package clarifai
 
+import "sort"
+
 // Response is a universal Clarifai API response object.
 type Response struct {
-	Status        *ServiceStatus  `json:"status,omitempty"`
-	Outputs       []*Output       `json:"outputs,omitempty"`
-	Input         *Input          `json:"input,omitempty"` // Request for one input.
-	Inputs        []*Input        `json:"inputs,omitempty"`
-	Hits          []*Hit          `json:"hits,omitempty"` // Search hits.
-	Model         *Model          `json:"model,omitempty"`
-	Models        []*Model        `json:"models,omitempty"`
-	ModelVersion  *ModelVersion   `json:"model_version,omitempty"`
-	ModelVersions []*ModelVersion `json:"model_versions,omitempty"`
+	Status           *ServiceStatus     `json:"status,omitempty"`
+	Outputs          []*Output          `json:"outputs,omitempty"`
+	Input            *Input             `json:"input,omitempty"` // Request for one input.
+	Inputs           []*Input           `json:"inputs,omitempty"`
+	Hits             []*Hit             `json:"hits,omitempty"` // Search hits.
+	Model            *Model             `json:"model,omitempty"`
+	Models           []*Model           `json:"models,omitempty"`
+	ModelVersion     *ModelVersion      `json:"model_version,omitempty"`
+	ModelVersions    []*ModelVersion    `json:"model_versions,omitempty"`
+	Workflow         *Workflow          `json:"workflow,omitempty"`
+	Results          []*WorkflowResult  `json:"results,omitempty"` // Workflow predict results.
+	Concept          *Concept           `json:"concept,omitempty"`
+	Concepts         []*Concept         `json:"concepts,omitempty"`
+	Clusters         []*Cluster         `json:"clusters,omitempty"`
+	ConceptRelations []*ConceptRelation `json:"concept_relations,omitempty"`
+	Apps             []*App             `json:"apps,omitempty"`
+	Counts           *InputCounts       `json:"counts,omitempty"` // Input counts of GetInputStatuses.
+}
+
+// PredictResponse is a response of a predict call.
+type PredictResponse struct {
+	Status  *ServiceStatus `json:"status,omitempty"`
+	Outputs []*Output      `json:"outputs,omitempty"`
+}
+
+// OutputByInputID returns an output predicted for an input with a given ID, or nil if there is none.
+func (p *PredictResponse) OutputByInputID(id string) *Output {
+	for _, o := range p.Outputs {
+		if o.Input != nil && o.Input.ID == id {
+			return o
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalPredict sends a predict request and returns its parsed response.
+// Frames of video outputs are sorted by time. A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalPredict() (*PredictResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &PredictResponse{
+		Status:  resp.Status,
+		Outputs: resp.Outputs,
+	}
+
+	for _, o := range p.Outputs {
+		if o.Data != nil {
+			sortFrames(o.Data.Frames)
+		}
+	}
+
+	return p, err
+}
+
+// sortFrames sorts video frames by their time.
+func sortFrames(frames []*Frame) {
+	sort.SliceStable(frames, func(i, j int) bool {
+		if frames[i].FrameInfo == nil || frames[j].FrameInfo == nil {
+			return false
+		}
+		return frames[i].FrameInfo.Time < frames[j].FrameInfo.Time
+	})
+}
+
+// Pagination describes a page of a list response. API does not report a total number of items,
+// so a page with fewer items than requested is the last one.
+type Pagination struct {
+	Page    int // Page number of the request.
+	PerPage int // Number of items per page of the request.
+	Total   int // Number of items received on the page. API returns no total count, so this is not a count of all items.
+}
+
+// HasMore reports whether there may be more items on the next page.
+func (p *Pagination) HasMore() bool {
+	return p.PerPage > 0 && p.Total >= p.PerPage
+}
+
+// pagination describes a page of total items received in a response to the request.
+func (r *Request) pagination(total int) *Pagination {
+	return &Pagination{
+		Page:    r.page,
+		PerPage: r.perPage,
+		Total:   total,
+	}
+}
+
+// InputsResponse is a response of a call listing inputs.
+type InputsResponse struct {
+	Status     *ServiceStatus `json:"status,omitempty"`
+	Inputs     []*Input       `json:"inputs,omitempty"`
+	Pagination *Pagination    `json:"-"`
+}
+
+// UnmarshalInputs sends a request listing inputs and returns its parsed response.
+// A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalInputs() (*InputsResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &InputsResponse{
+		Status:     resp.Status,
+		Inputs:     resp.Inputs,
+		Pagination: r.pagination(len(resp.Inputs)),
+	}
+
+	return p, err
+}
+
+// ModelsResponse is a response of a call listing models.
+type ModelsResponse struct {
+	Status     *ServiceStatus `json:"status,omitempty"`
+	Models     []*Model       `json:"models,omitempty"`
+	Pagination *Pagination    `json:"-"`
+}
+
+// UnmarshalModels sends a request listing models and returns its parsed response.
+// A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalModels() (*ModelsResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &ModelsResponse{
+		Status:     resp.Status,
+		Models:     resp.Models,
+		Pagination: r.pagination(len(resp.Models)),
+	}
+
+	return p, err
+}
+
+// UnmarshalModel sends a request returning a single model, e.g. CreateModel or GetModel, and returns the parsed model.
+// A non-successful status of the response is returned as an *APIError.
+func (r *Request) UnmarshalModel() (*Model, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	return resp.Model, err
+}
+
+// SearchResponse is a response of a search call.
+type SearchResponse struct {
+	Status *ServiceStatus `json:"status,omitempty"`
+	Hits   []*Hit         `json:"hits,omitempty"`
+}
+
+// UnmarshalSearch sends a search request and returns its parsed response with matched hits and their scores.
+// Hits are sorted by score, the best match goes first, and filtered by SearchRequest.SetMinScore, if set. A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalSearch() (*SearchResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &SearchResponse{
+		Status: resp.Status,
+		Hits:   resp.Hits,
+	}
+
+	if q, ok := r.payload.(*SearchRequest); ok && q.minScore > 0 {
+		hits := make([]*Hit, 0, len(p.Hits))
+		for _, h := range p.Hits {
+			if h.Score >= q.minScore {
+				hits = append(hits, h)
+			}
+		}
+		p.Hits = hits
+	}
+
+	sort.SliceStable(p.Hits, func(i, j int) bool {
+		return p.Hits[i].Score > p.Hits[j].Score
+	})
+
+	return p, err
+}
+
+// ConceptsResponse is a response of a call listing concepts.
+type ConceptsResponse struct {
+	Status     *ServiceStatus `json:"status,omitempty"`
+	Concepts   []*Concept     `json:"concepts,omitempty"`
+	Pagination *Pagination    `json:"-"`
+}
+
+// UnmarshalConcepts sends a request listing concepts and returns its parsed response.
+// A response of GetConcept is returned as a list of one concept. A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalConcepts() (*ConceptsResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &ConceptsResponse{
+		Status:   resp.Status,
+		Concepts: resp.Concepts,
+	}
+
+	if resp.Concept != nil {
+		p.Concepts = append(p.Concepts, resp.Concept)
+	}
+	p.Pagination = r.pagination(len(p.Concepts))
+
+	return p, err
+}
+
+// ClustersResponse is a response of a call listing input clusters.
+type ClustersResponse struct {
+	Status   *ServiceStatus `json:"status,omitempty"`
+	Clusters []*Cluster     `json:"clusters,omitempty"`
+}
+
+// UnmarshalClusters sends a request listing input clusters and returns its parsed response.
+// Hits of every cluster are sorted by score, the best match goes first. A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalClusters() (*ClustersResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &ClustersResponse{
+		Status:   resp.Status,
+		Clusters: resp.Clusters,
+	}
+
+	for _, c := range p.Clusters {
+		hits := c.Hits
+		sort.SliceStable(hits, func(i, j int) bool {
+			return hits[i].Score > hits[j].Score
+		})
+	}
+
+	return p, err
 }
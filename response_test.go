@@ -0,0 +1,291 @@
+package clarifai
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRequest_UnmarshalPredict(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/ok_predict_1img.json")
+
+	r := InitInputs()
+	_ = r.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	resp, err := sess.Predict(r).UnmarshalPredict()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Outputs) != 1 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Outputs), 1)
+	}
+
+	actual := resp.Outputs[0].Data.Concepts[0]
+	expected := &OutputConcept{
+		ID:    "ai_HLmqFqBf",
+		Name:  "train",
+		Value: 0.9989112,
+	}
+
+	CompareStructs(t, expected, actual)
+}
+
+func TestRequest_UnmarshalPredict_ConceptDetails(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/ok_10000_predict_concept_details.json")
+
+	r := InitInputs()
+	_ = r.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	resp, err := sess.Predict(r).UnmarshalPredict()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual := resp.Outputs[0].Data.Concepts[1]
+	expected := &OutputConcept{
+		AppID:    String("main"),
+		ID:       "ai_fvlBqXZR",
+		Name:     "railway",
+		Value:    0.9975532,
+		Language: "en",
+	}
+
+	CompareStructs(t, expected, actual)
+}
+
+func TestPredictResponse_OutputByInputID(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/ok_predict_2img.json")
+
+	r := InitInputs()
+	_ = r.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "fcc8b470554341fca20d95fe2b4ff034")
+	_ = r.AddInput(NewImageFromURL("https://samples.clarifai.com/wedding.jpg"), "cff4b31d5b1f4ea187bdff132cb234ec")
+
+	req := sess.Predict(r)
+
+	actual, err := json.Marshal(req.payload)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if !strings.Contains(string(actual), `"id":"fcc8b470554341fca20d95fe2b4ff034"`) || !strings.Contains(string(actual), `"id":"cff4b31d5b1f4ea187bdff132cb234ec"`) {
+		t.Errorf("Actual: %s, expected input IDs in a predict request", actual)
+	}
+
+	resp, err := req.UnmarshalPredict()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	o := resp.OutputByInputID("cff4b31d5b1f4ea187bdff132cb234ec")
+	if o == nil || o != resp.Outputs[1] {
+		t.Errorf("Actual: %+v, expected: %+v", o, resp.Outputs[1])
+	}
+
+	if o := resp.OutputByInputID("foo"); o != nil {
+		t.Errorf("Actual: %+v, expected: %v", o, nil)
+	}
+}
+
+func TestRequest_UnmarshalPredict_Fail(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/fail_11100_bad_req_supply_inputs.json")
+
+	resp, err := sess.Predict(InitInputs()).UnmarshalPredict()
+	if err == nil {
+		t.Fatal("Should have an error for an unsuccessful status")
+	}
+
+	if resp == nil || resp.Status.Code != 11100 {
+		t.Errorf("Actual: %+v, expected a response with status code %v", resp, 11100)
+	}
+}
+
+func TestRequest_UnmarshalSearch(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "searches", "resp/ok_10000_search_by_user_supplied_concept.json")
+
+	q := NewAndSearchQuery()
+	q.WithUserConcept("album")
+
+	resp, err := sess.Search(q).UnmarshalSearch()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Hits) != 1 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Hits), 1)
+	}
+
+	if resp.Hits[0].Score != 1 {
+		t.Errorf("Actual: %v, expected: %v", resp.Hits[0].Score, 1)
+	}
+
+	if resp.Hits[0].Input.ID != "e0b800a0eb444a80ac6f13073a15a548" {
+		t.Errorf("Actual: %v, expected: %v", resp.Hits[0].Input.ID, "e0b800a0eb444a80ac6f13073a15a548")
+	}
+}
+
+func TestRequest_UnmarshalSearch_MinScore(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "searches", "resp/ok_10000_reverse_image_search_2img.json")
+
+	q := NewAndSearchQuery()
+	q.WithImage(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"))
+
+	if err := q.SetMinScore(0.795); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	resp, err := sess.Search(q).UnmarshalSearch()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Hits) != 1 || resp.Hits[0].Score != 0.79598457 {
+		t.Errorf("Actual: %+v, expected a single hit with score %v", resp.Hits, 0.79598457)
+	}
+
+	if err = q.SetMinScore(1.1); err != ErrInvalidMinScore {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidMinScore)
+	}
+}
+
+func TestRequest_UnmarshalModels(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models", "resp/ok_10000_get_models.json")
+
+	resp, err := sess.GetModels().WithPagination(1, 10).UnmarshalModels()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Models) != 1 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Models), 1)
+	}
+
+	m := resp.Models[0]
+	if StringValue(m.ID) != "eab1fd01a5544225b32d5d2937e05041" {
+		t.Errorf("Actual: %v, expected: %v", StringValue(m.ID), "eab1fd01a5544225b32d5d2937e05041")
+	}
+
+	if m.ModelVersion == nil || m.ModelVersion.ID != "d88847bb75514fceaf74bf36606d1343" {
+		t.Errorf("Actual: %+v, expected version: %v", m.ModelVersion, "d88847bb75514fceaf74bf36606d1343")
+	}
+
+	if resp.Pagination.HasMore() {
+		t.Errorf("Actual: %v, expected: %v", true, false)
+	}
+}
+
+func TestRequest_UnmarshalInputs(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "inputs", "resp/ok_inputs.json")
+
+	resp, err := sess.GetAllInputsWithPagination(1, 2).UnmarshalInputs()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Inputs) == 0 {
+		t.Fatal("Inputs should not be empty")
+	}
+
+	expected := &Pagination{Page: 1, PerPage: 2, Total: len(resp.Inputs)}
+	CompareStructs(t, expected, resp.Pagination)
+}
+
+func TestRequest_UnmarshalModel(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models", "resp/ok_10000_create_model.json")
+
+	m, err := sess.CreateModel("test-model", nil).UnmarshalModel()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if StringValue(m.ID) != "test-id-1" {
+		t.Errorf("Actual: %v, expected: %v", StringValue(m.ID), "test-id-1")
+	}
+
+	if m.ModelVersion.ID != "f9320f4aa9be46bbaa1384fc12f61c1d" {
+		t.Errorf("Actual: %v, expected: %v", m.ModelVersion.ID, "f9320f4aa9be46bbaa1384fc12f61c1d")
+	}
+}
+
+func TestRequest_UnmarshalConcepts(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "concepts", "resp/ok_10000_get_concepts.json")
+
+	resp, err := sess.GetConcepts().WithPagination(2, 2).UnmarshalConcepts()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Concepts) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Concepts), 2)
+	}
+
+	if resp.Concepts[0].ID != "cat" {
+		t.Errorf("Actual: %v, expected: %v", resp.Concepts[0].ID, "cat")
+	}
+
+	expected := &Pagination{Page: 2, PerPage: 2, Total: 2}
+	CompareStructs(t, expected, resp.Pagination)
+
+	if !resp.Pagination.HasMore() {
+		t.Errorf("Actual: %v, expected: %v", false, true)
+	}
+}
+
+func TestRequest_UnmarshalConcepts_Single(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "concepts/cat", "resp/ok_10000_get_concept.json")
+
+	resp, err := sess.GetConcept("cat").UnmarshalConcepts()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Concepts) != 1 || resp.Concepts[0].Name != "cat" {
+		t.Errorf("Actual: %+v, expected a single concept %v", resp.Concepts, "cat")
+	}
+}
+
+func TestRequest_UnmarshalClusters(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/foo/clusters", "resp/ok_10000_get_input_clusters.json")
+
+	resp, err := sess.GetInputClusters("foo").UnmarshalClusters()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Clusters) != 1 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Clusters), 1)
+	}
+
+	c := resp.Clusters[0]
+	if c.ID != "cluster-1" || c.Count != 2 {
+		t.Errorf("Actual: %+v, expected cluster %v of 2 inputs", c, "cluster-1")
+	}
+
+	if c.Hits[0].Score != 0.93 || c.Hits[0].Input.ID != "c2b94a77f99b4d908574ade5533b9831" {
+		t.Errorf("Actual: %+v, expected the best hit first", c.Hits[0])
+	}
+}
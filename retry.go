@@ -0,0 +1,92 @@
+package clarifai
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// SetRetryPolicy enables retries of failed calls with an exponential backoff starting at baseDelay.
+// GET requests are retried on "429 Too Many Requests" and 5xx responses. Other methods are not idempotent
+// and are retried on 429 only, to avoid duplicate writes. A Retry-After header of the response is honored when present.
+// By default, calls are not retried.
+func (s *Session) SetRetryPolicy(maxRetries int, baseDelay time.Duration) {
+	s.maxRetries = maxRetries
+	s.retryBaseDelay = baseDelay
+}
+
+// do sends an HTTP request, retrying it according to the retry policy of the session.
+func (s *Session) do(req *http.Request) (*http.Response, error) {
+
+	for attempt := 0; ; attempt++ {
+		if s.limiter != nil {
+			if err := s.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		res, err := s.client().Do(req)
+		s.log(req, res, start)
+
+		if err != nil || attempt >= s.maxRetries || !isRetryable(req.Method, res.StatusCode) {
+			return res, err
+		}
+
+		delay := retryDelay(res, s.retryBaseDelay, attempt)
+
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+
+		// Rewind the request body for the next attempt.
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, req.Context().Err()
+		case <-t.C:
+		}
+	}
+}
+
+// isRetryable checks if a call may be repeated after a response with a given HTTP status.
+func isRetryable(method string, status int) bool {
+
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+
+	if status >= 500 && status <= 599 {
+		return method == http.MethodGet || method == http.MethodHead
+	}
+
+	return false
+}
+
+// retryDelay returns a delay before the next attempt, based on the Retry-After header or an exponential backoff.
+func retryDelay(res *http.Response, baseDelay time.Duration, attempt int) time.Duration {
+
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+			return time.Duration(sec) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d
+			}
+			return 0
+		}
+	}
+
+	return baseDelay << uint(attempt)
+}
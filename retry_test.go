@@ -0,0 +1,134 @@
+package clarifai
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsRetryable(t *testing.T) {
+
+	tests := []struct {
+		method   string
+		status   int
+		expected bool
+	}{
+		{http.MethodGet, http.StatusTooManyRequests, true},
+		{http.MethodGet, http.StatusBadGateway, true},
+		{http.MethodGet, http.StatusNotFound, false},
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodPost, http.StatusServiceUnavailable, false},
+		{http.MethodPatch, http.StatusInternalServerError, false},
+		{http.MethodDelete, http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		actual := isRetryable(tt.method, tt.status)
+		if actual != tt.expected {
+			t.Errorf("%s %d | Actual: %v, expected: %v", tt.method, tt.status, actual, tt.expected)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+
+	res := &http.Response{Header: http.Header{}}
+
+	if d := retryDelay(res, 100*time.Millisecond, 2); d != 400*time.Millisecond {
+		t.Errorf("Actual: %v, expected: %v", d, 400*time.Millisecond)
+	}
+
+	res.Header.Set("Retry-After", "3")
+
+	if d := retryDelay(res, 100*time.Millisecond, 2); d != 3*time.Second {
+		t.Errorf("Actual: %v, expected: %v", d, 3*time.Second)
+	}
+}
+
+func TestSession_SetRetryPolicy(t *testing.T) {
+
+	serverReset()
+
+	calls := 0
+	mux.HandleFunc("/"+apiVersion+"/inputs/retry", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetRetryPolicy(3, time.Millisecond)
+
+	_, err := app.HTTPCall(http.MethodGet, "inputs/retry", nil)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Actual: %v, expected: %v", calls, 3)
+	}
+}
+
+func TestSession_SetRetryPolicy_NoRetryOnPost5xx(t *testing.T) {
+
+	serverReset()
+
+	calls := 0
+	mux.HandleFunc("/"+apiVersion+"/inputs/retry", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetRetryPolicy(3, time.Millisecond)
+
+	_, err := app.HTTPCall(http.MethodPost, "inputs/retry", InitInputs())
+	if err == nil {
+		t.Fatal("Should have an error for a 5xx response")
+	}
+
+	if calls != 1 {
+		t.Errorf("Actual: %v, expected: %v", calls, 1)
+	}
+}
+
+func TestSession_SetRetryPolicy_PostRetryOn429(t *testing.T) {
+
+	serverReset()
+
+	calls := 0
+	mux.HandleFunc("/"+apiVersion+"/inputs/retry", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if len(body) == 0 {
+			t.Errorf("Attempt %d | Request body should not be empty", calls)
+		}
+
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetRetryPolicy(1, time.Millisecond)
+
+	_, err := app.HTTPCall(http.MethodPost, "inputs/retry", InitInputs())
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Actual: %v, expected: %v", calls, 2)
+	}
+}
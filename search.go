@@ -16,6 +16,13 @@ type SearchRequest struct {
 	QueryObject *QueryObject `json:"query,omitempty"`
 	Type        string       `json:"-"`
 	Pagination  *pagination  `json:"pagination,omitempty"`
+	minScore    float64      // Hits with a lower score are dropped by UnmarshalSearch.
+}
+
+// valueRange is a concept value constraint of a range search term.
+type valueRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
 }
 
 type pagination struct {
@@ -23,6 +30,10 @@ type pagination struct {
 	PerPage int `json:"per_page"`
 }
 
+func (q *SearchRequest) setPagination(p *pagination) {
+	q.Pagination = p
+}
+
 // NewSearchQuery initializes a new SearchRequest object with search query properties.
 func NewSearchQuery(t string) *SearchRequest {
 	return &SearchRequest{
@@ -62,6 +73,39 @@ func (r *SearchRequest) WithoutUserConcept(c string) {
 	r.addFragment(&qf)
 }
 
+// WithoutConcept adds a negated match condition to the user-defined set of concepts, i.e. it excludes inputs
+// having the concept with a given value: WithoutConcept("dog", true) excludes inputs labeled as dogs.
+func (r *SearchRequest) WithoutConcept(name string, value bool) {
+	if value {
+		r.WithoutUserConcept(name)
+		return
+	}
+
+	r.WithUserConcept(name)
+}
+
+// WithConceptRange adds a match condition for user-defined concepts with a value between min and max inclusive.
+func (r *SearchRequest) WithConceptRange(name string, min, max float64) error {
+
+	if min > max {
+		return ErrInvalidConceptRange
+	}
+
+	i := Input{}
+	i.AddConcept(name, &valueRange{
+		Min: min,
+		Max: max,
+	})
+
+	qf := QueryFragment{
+		Input: &i,
+	}
+
+	r.addFragment(&qf)
+
+	return nil
+}
+
 // WithAPIConcept adds a positive match condition to the API-defined set of concepts.
 func (r *SearchRequest) WithAPIConcept(c string) {
 
@@ -102,6 +146,21 @@ func (r *SearchRequest) WithImage(i *Image) {
 	r.addFragment(&qf)
 }
 
+// WithInputID adds a positive match condition by an image of an input, which is already added to an app.
+// Unlike WithImage, the image is not sent again.
+func (r *SearchRequest) WithInputID(id string) {
+
+	qf := QueryFragment{
+		Output: &QueryOutput{
+			Input: &Input{
+				ID: id,
+			},
+		},
+	}
+
+	r.addFragment(&qf)
+}
+
 // WithMetadata adds a match filter for inputs, that were added with custom metadata.
 func (r *SearchRequest) WithMetadata(m interface{}) {
 	i := &Input{}
@@ -114,6 +173,46 @@ func (r *SearchRequest) WithMetadata(m interface{}) {
 	r.addFragment(&qf)
 }
 
+// WithGeoRadius adds a match filter for inputs located within a radius of a geo point.
+// Unit is one of GeoLimitWithinMiles, GeoLimitWithinKilometers, GeoLimitWithinDegrees or GeoLimitWithinRadians.
+func (r *SearchRequest) WithGeoRadius(longitude, latitude, radius float64, unit string) error {
+
+	p, err := newGeoPoint(longitude, latitude)
+	if err != nil {
+		return err
+	}
+
+	l, err := newGeoLimit(radius, unit)
+	if err != nil {
+		return err
+	}
+
+	qf := QueryFragment{
+		Input: &Input{
+			Data: &Image{
+				Geo: &Geo{
+					GeoPoint: p,
+					GeoLimit: l,
+				},
+			},
+		},
+	}
+
+	r.addFragment(&qf)
+
+	return nil
+}
+
+// SetMinScore sets a minimum score of hits returned by UnmarshalSearch. Filtering is done on the client side.
+func (r *SearchRequest) SetMinScore(v float64) error {
+	if v < 0 || v > 1 {
+		return ErrInvalidMinScore
+	}
+
+	r.minScore = v
+	return nil
+}
+
 // addFragment adds fragment to the current clause of the query.
 func (r *SearchRequest) addFragment(qf *QueryFragment) {
 	if r.Type == SearchQueryTypeAnd {
@@ -129,3 +228,12 @@ func (s *Session) Search(p *SearchRequest) *Request {
 
 	return r
 }
+
+// SearchByImage issues a reverse image search request to find inputs visually similar to a provided image.
+func (s *Session) SearchByImage(im *Image) *Request {
+
+	q := NewAndSearchQuery()
+	q.WithImage(im)
+
+	return s.Search(q)
+}
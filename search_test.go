@@ -1,6 +1,7 @@
 package clarifai
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 )
@@ -101,6 +102,34 @@ func TestSearchRequest_WithImage(t *testing.T) {
 	}
 }
 
+func TestSearchRequest_WithInputID(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "searches", "resp/ok_10000_reverse_image_search_1img.json")
+
+	q := NewAndSearchQuery()
+	q.WithInputID("foo")
+
+	actual, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"query":{"ands":[{"output":{"input":{"id":"foo"}}}]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+
+	resp, err := sess.Search(q).UnmarshalSearch()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Hits) != 2 {
+		t.Errorf("Actual: %v, expected: %v", len(resp.Hits), 2)
+	}
+}
+
 func TestSearchRequest_WithMetadata(t *testing.T) {
 
 	q := NewAndSearchQuery()
@@ -113,3 +142,169 @@ func TestSearchRequest_WithMetadata(t *testing.T) {
 		t.Errorf("WithMetadata | Actual: %v, expected: %v", q.QueryObject.Ands[0].Input.Data.Metadata, expected)
 	}
 }
+
+func TestSession_SearchByImage(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "searches", "resp/ok_10000_reverse_image_search_1img.json")
+
+	i := NewImageFromURL("https://samples.clarifai.com/metro-north.jpg")
+
+	r := sess.SearchByImage(i)
+
+	p := r.payload.(*SearchRequest)
+	if p.QueryObject.Ands[0].Output.Input.Data != i {
+		t.Errorf("Actual: %v, expected: %v", p.QueryObject.Ands[0].Output.Input.Data, i)
+	}
+
+	resp, err := r.UnmarshalSearch()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if len(resp.Hits) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Hits), 2)
+	}
+
+	if resp.Hits[0].Score < resp.Hits[1].Score {
+		t.Errorf("Hits should be sorted by score, got %v before %v", resp.Hits[0].Score, resp.Hits[1].Score)
+	}
+}
+
+func TestSearchRequest_WithMetadata_Nested(t *testing.T) {
+
+	q := NewAndSearchQuery()
+	q.WithMetadata(map[string]interface{}{
+		"sku": "1234",
+		"store": map[string]interface{}{
+			"id":     42,
+			"region": "emea",
+		},
+	})
+
+	actual, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"query":{"ands":[{"input":{"data":{"metadata":{"sku":"1234","store":{"id":42,"region":"emea"}}}}}]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSearchRequest_WithGeoRadius(t *testing.T) {
+
+	q := NewAndSearchQuery()
+	err := q.WithGeoRadius(-30, 40, 1.5, GeoLimitWithinKilometers)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"query":{"ands":[{"input":{"data":{"geo":{"geo_point":{"longitude":-30,"latitude":40},"geo_limit":{"type":"withinKilometers","value":1.5}}}}}]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSearchRequest_WithGeoRadius_Invalid(t *testing.T) {
+
+	q := NewAndSearchQuery()
+
+	if err := q.WithGeoRadius(-30, 40, 1, "withinFeet"); err != ErrInvalidGeoLimitType {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidGeoLimitType)
+	}
+
+	if err := q.WithGeoRadius(-30, 40, 0, GeoLimitWithinMiles); err != ErrInvalidGeoRadius {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidGeoRadius)
+	}
+
+	if err := q.WithGeoRadius(-200, 40, 1, GeoLimitWithinMiles); err != ErrInvalidLongitude {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidLongitude)
+	}
+
+	if len(q.QueryObject.Ands) != 0 {
+		t.Errorf("Invalid filters should not be added, got %v", len(q.QueryObject.Ands))
+	}
+}
+
+func TestSearchRequest_WithoutConcept(t *testing.T) {
+
+	q := NewAndSearchQuery()
+	q.WithUserConcept("cat")
+	q.WithoutConcept("dog", true)
+	q.WithoutConcept("toy", false)
+
+	actual, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"query":{"ands":[` +
+		`{"input":{"data":{"concepts":[{"name":"cat","value":true}]}}},` +
+		`{"input":{"data":{"concepts":[{"name":"dog","value":false}]}}},` +
+		`{"input":{"data":{"concepts":[{"name":"toy","value":true}]}}}]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSearchRequest_PositiveAndNegativeConcepts(t *testing.T) {
+
+	q := NewAndSearchQuery()
+	q.WithUserConcept("cat")
+	q.WithoutUserConcept("dog")
+	q.WithAPIConcept("animal")
+	q.WithoutAPIConcept("people")
+
+	actual, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"query":{"ands":[` +
+		`{"input":{"data":{"concepts":[{"name":"cat","value":true}]}}},` +
+		`{"input":{"data":{"concepts":[{"name":"dog","value":false}]}}},` +
+		`{"output":{"data":{"concepts":[{"name":"animal","value":true}]}}},` +
+		`{"output":{"data":{"concepts":[{"name":"people","value":false}]}}}]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSearchRequest_WithConceptRange(t *testing.T) {
+
+	q := NewAndSearchQuery()
+	if err := q.WithConceptRange("weight", 0.25, 0.75); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"query":{"ands":[{"input":{"data":{"concepts":[{"name":"weight","value":{"min":0.25,"max":0.75}}]}}}]}}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSearchRequest_WithConceptRange_Invalid(t *testing.T) {
+
+	q := NewAndSearchQuery()
+	err := q.WithConceptRange("weight", 0.75, 0.25)
+
+	if err != ErrInvalidConceptRange {
+		t.Errorf("Actual: %v, expected: %v", err, ErrInvalidConceptRange)
+	}
+
+	if len(q.QueryObject.Ands) != 0 {
+		t.Errorf("Invalid filters should not be added, got %v", len(q.QueryObject.Ands))
+	}
+}
@@ -2,35 +2,47 @@ package clarifai
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	apiHost    = "https://api.clarifai.com"
-	apiVersion = "v2"
+	apiHost          = "https://api.clarifai.com"
+	apiVersion       = "v2"
+	defaultUserAgent = "clarifai-client-go/" + Version
 )
 
-var (
-	userAgent string
-)
-
-func init() {
-	userAgent = "clarifai-client-go/" + ClientVersion
-}
-
 type Session struct {
 	apiKey          string
+	userID          string // Used with Personal Access Tokens to scope calls to a user app.
+	appID           string
 	clientID        string
 	clientSecret    string
 	accessToken     string
 	tokenExpiration int
+	mu              sync.Mutex // Guards the access token on concurrent calls.
 	host            string
+	version         string // API version segment of endpoint paths, apiVersion if empty.
+	userAgent       string // User-Agent header of calls, defaultUserAgent if empty.
+	httpClient      *http.Client
+	maxRetries      int
+	retryBaseDelay  time.Duration
+	limiter         RateLimiter
+	logger          Logger
+	autoRequestID   bool        // Generate request IDs of calls without one.
+	headers         http.Header // Default headers of all calls.
+	maxRespBytes    int64       // Maximum size of a response body, unlimited if 0.
+	gzipMinBytes    int         // Minimum size of a compressed request body, compression is disabled if 0.
+	inputLimit      int         // Maximum number of inputs of a call, InputLimit if 0.
+	closed          int32       // Set to 1 by Close.
 }
 
 type AuthResponse struct {
@@ -46,13 +58,6 @@ type AuthStatus struct {
 	Details     string `json:"details"`
 }
 
-// ServiceStatus is a universal status info object.
-type ServiceStatus struct {
-	Code        int    `json:"code"`
-	Description string `json:"description"`
-	Details     string `json:"details,omitempty"` // optional
-}
-
 // Create session object with authentication by API Key
 func NewApp(apiKey string) *Session {
 	return &Session{
@@ -61,6 +66,17 @@ func NewApp(apiKey string) *Session {
 	}
 }
 
+// NewSessionWithPAT creates a session object with authentication by Personal Access Token.
+// Calls are scoped to an application appID of a user userID.
+func NewSessionWithPAT(pat, userID, appID string) *Session {
+	return &Session{
+		apiKey: pat,
+		userID: userID,
+		appID:  appID,
+		host:   apiHost,
+	}
+}
+
 // NewSession returns a default session object.
 func Connect(clientID, clientSecret string) (*Session, error) {
 	sess := NewSession(clientID, clientSecret)
@@ -81,23 +97,148 @@ func NewSession(clientID, clientSecret string) *Session {
 	}
 }
 
+// SetBaseURL sets the API host to send requests to, e.g. an on-premise deployment, a proxy or a mock server.
+// The URL should not include the API version segment. Default is https://api.clarifai.com.
+func (s *Session) SetBaseURL(url string) {
+	s.host = strings.TrimRight(url, "/")
+}
+
+// SetAPIVersion sets the API version segment of endpoint paths, e.g. to pin or target another version of the API.
+// An empty version restores the default one, v2.
+func (s *Session) SetAPIVersion(v string) {
+	s.version = strings.Trim(v, "/")
+}
+
+// apiVersion returns the API version segment of the session.
+func (s *Session) apiVersion() string {
+	if s.version == "" {
+		return apiVersion
+	}
+
+	return s.version
+}
+
+// SetUserAgent sets the User-Agent header of all calls, e.g. to tell the calling service in logs.
+// An empty value restores the default one, clarifai-client-go/<Version>.
+func (s *Session) SetUserAgent(ua string) {
+	s.userAgent = ua
+}
+
+// userAgentHeader returns the User-Agent header of the session.
+func (s *Session) userAgentHeader() string {
+	if s.userAgent == "" {
+		return defaultUserAgent
+	}
+
+	return s.userAgent
+}
+
+// SetHTTPClient sets an HTTP client used for all calls of the session, e.g. to configure timeouts, proxies or TLS.
+// By default, http.DefaultClient is used.
+func (s *Session) SetHTTPClient(c *http.Client) {
+	s.httpClient = c
+}
+
+// client returns an HTTP client of the session.
+func (s *Session) client() *http.Client {
+	if s.httpClient == nil {
+		return http.DefaultClient
+	}
+
+	return s.httpClient
+}
+
+// SetDefaultHeader sets a header sent with every call of the session, including the token call, e.g. for an API gateway.
+// Headers set by Request.SetHeader take precedence.
+func (s *Session) SetDefaultHeader(key, value string) {
+	if s.headers == nil {
+		s.headers = http.Header{}
+	}
+	s.headers.Set(key, value)
+}
+
+// SetMaxResponseBytes limits the size of response bodies, a call with a larger response fails with ErrResponseTooLarge.
+// By default, response size is unlimited.
+func (s *Session) SetMaxResponseBytes(n int64) {
+	s.maxRespBytes = n
+}
+
+// SetInputLimit sets the maximum number of inputs of a call, e.g. for an on-premise deployment with another batch size.
+// The limit applies to inputs made by Session.InitInputs and to batching helpers of the session. Default is InputLimit.
+func (s *Session) SetInputLimit(n int) error {
+	if n <= 0 {
+		return ErrInvalidInputLimit
+	}
+
+	s.inputLimit = n
+	return nil
+}
+
+// maxInputs returns the maximum number of inputs of a call of the session.
+func (s *Session) maxInputs() int {
+	if s.inputLimit == 0 {
+		return InputLimit
+	}
+
+	return s.inputLimit
+}
+
+// Close releases resources of the session, i.e. idle connections of a client set by SetHTTPClient.
+// Calls made after Close fail with ErrSessionClosed, so do pollers like WaitForInputsProcessed.
+// Closing a closed session is a no-op.
+func (s *Session) Close() error {
+	if atomic.SwapInt32(&s.closed, 1) == 1 {
+		return nil
+	}
+
+	// The default client is shared with the rest of the program.
+	if s.httpClient != nil {
+		s.httpClient.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+// SetAutoRequestID enables generation of a random X-Clarifai-Request-ID header for requests
+// with no ID set by Request.SetRequestID.
+func (s *Session) SetAutoRequestID(enabled bool) {
+	s.autoRequestID = enabled
+}
+
 // Connect contacts Clarifai API, tries to authenticate and returns access data on success.
 func (s *Session) Connect() error {
+	return s.ConnectContext(context.Background())
+}
+
+// ConnectContext is like Connect, but the authentication call is bound to the provided context.
+func (s *Session) ConnectContext(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.connect(ctx)
+}
+
+// connect requests a new access token. The caller must hold s.mu.
+func (s *Session) connect(ctx context.Context) error {
 
 	form := url.Values{}
 	form.Set("grant_type", "client_credentials")
 
-	req, err := http.NewRequest(http.MethodPost, s.buildURI("token"), strings.NewReader(form.Encode()))
-	req.SetBasicAuth(s.clientID, s.clientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.buildURI("token"), strings.NewReader(form.Encode()))
 	if err != nil {
 		return err
 	}
+	req.SetBasicAuth(s.clientID, s.clientSecret)
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("User-Agent", s.userAgentHeader())
 
-	httpClient := &http.Client{}
-	res, err := httpClient.Do(req)
+	// Default headers apply to the token call too, e.g. a gateway may require them from all calls.
+	for k, v := range s.headers {
+		req.Header[k] = v
+	}
+
+	res, err := s.client().Do(req)
 	if err != nil {
 		return err
 	}
@@ -133,60 +274,173 @@ func (s *Session) setTokenExpiration(exp int) (int, int) {
 }
 
 // HTTPCall is a universal service caller with (re-)authentication and unmarshalling.
+// If the API reports a failure, the response is returned along with an *APIError.
 func (s *Session) HTTPCall(method, path string, payload interface{}) (*Response, error) {
+	return s.HTTPCallContext(context.Background(), method, path, payload)
+}
+
+// HTTPCallContext is like HTTPCall, but the HTTP request is bound to the provided context,
+// so cancelling the context aborts the call.
+func (s *Session) HTTPCallContext(ctx context.Context, method, path string, payload interface{}) (*Response, error) {
+
+	req, err := s.newHTTPRequest(ctx, method, path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, _, err := s.send(req)
+	return resp, err
+}
+
+// newHTTPRequest creates an authorized HTTP request to an API endpoint with a JSON payload, if any.
+func (s *Session) newHTTPRequest(ctx context.Context, method, path string, payload interface{}) (*http.Request, error) {
 
-	var resp *Response
-	var err error
 	var p io.Reader
 
-	// Check for token expiration. If expired, re-authorize.
-	if s.apiKey == "" && s.isTokenExpired() {
-		err = s.Connect()
-		if err != nil {
-			return resp, err
-		}
+	if atomic.LoadInt32(&s.closed) == 1 {
+		return nil, ErrSessionClosed
+	}
+
+	token, err := s.token(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if payload != nil {
+	st, streamed := payload.(streamer)
+	compressed := false
+
+	if payload != nil && !streamed {
 		p, err = prepPayload(payload)
 		if err != nil {
-			return resp, err
+			return nil, err
+		}
+
+		if b, ok := p.(*bytes.Reader); ok && s.gzipMinBytes > 0 && b.Len() >= s.gzipMinBytes {
+			p, err = compressBody(b)
+			if err != nil {
+				return nil, err
+			}
+			compressed = true
 		}
 	}
-	req, err := http.NewRequest(method, s.buildURI(path), p)
+	req, err := http.NewRequestWithContext(ctx, method, s.buildURI(path), p)
 	if err != nil {
-		return resp, err
+		return nil, err
+	}
+
+	// A streamed body has unknown length and is re-encoded for every retry.
+	if streamed {
+		req.Body = newStreamBody(st)
+		req.GetBody = func() (io.ReadCloser, error) {
+			return newStreamBody(st), nil
+		}
 	}
 	if s.apiKey == "" {
-		req.Header.Set("Authorization", "Bearer "+s.accessToken)
+		req.Header.Set("Authorization", "Bearer "+token)
 	} else {
 		req.Header.Set("Authorization", "Key "+s.apiKey)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
+	req.Header.Set("User-Agent", s.userAgentHeader())
+	if compressed {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
 
-	httpClient := &http.Client{}
-	res, err := httpClient.Do(req)
+	for k, v := range s.headers {
+		req.Header[k] = v
+	}
+
+	return req, nil
+}
+
+// send sends an HTTP request to API and unmarshals its response. The raw response body is returned as well.
+func (s *Session) send(req *http.Request) (*Response, []byte, error) {
+
+	var resp *Response
+
+	res, err := s.do(req)
 	if err != nil {
-		return resp, err
+		return resp, nil, err
 	}
 	defer res.Body.Close()
 
-	body, err := ioutil.ReadAll(res.Body)
+	var r io.Reader = res.Body
+	if res.Header.Get("Content-Encoding") == "gzip" {
+		r, err = decompressBody(res.Body)
+		if err != nil {
+			return resp, nil, err
+		}
+	}
+
+	body, err := s.readBody(r)
 	if err != nil {
-		return resp, err
+		return resp, nil, err
 	}
 
 	err = json.Unmarshal(body, &resp)
+
+	// Non-2xx responses may come without a valid JSON body, e.g. from a proxy.
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		if err != nil {
+			body = nil
+		}
+		return resp, body, newAPIError(res.StatusCode, resp)
+	}
+
 	if err != nil {
-		return resp, err
+		return resp, nil, err
+	}
+
+	if resp != nil && resp.Status != nil && resp.Status.Code != StatusSuccess {
+		return resp, body, newAPIError(res.StatusCode, resp)
 	}
 
-	return resp, nil
+	return resp, body, nil
+}
+
+// readBody reads a response body within the response size limit of the session.
+func (s *Session) readBody(r io.Reader) ([]byte, error) {
+
+	if s.maxRespBytes <= 0 {
+		return ioutil.ReadAll(r)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r, s.maxRespBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(body)) > s.maxRespBytes {
+		return nil, ErrResponseTooLarge
+	}
+
+	return body, nil
+}
+
+// token returns a current access token. If expired, re-authorizes first.
+func (s *Session) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.apiKey == "" && s.isTokenExpired() {
+		err := s.connect(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return s.accessToken, nil
 }
 
 // buildURI constructs a full endpoint URI based of request path, API host and current API version.
+// Sessions authenticated by Personal Access Token are scoped to the user app.
 func (s *Session) buildURI(endpoint string) string {
-	return s.host + "/" + apiVersion + "/" + endpoint
+	if s.userID != "" && s.appID != "" && !isUserScoped(endpoint) {
+		endpoint = "users/" + s.userID + "/apps/" + s.appID + "/" + endpoint
+	}
+
+	return s.host + "/" + s.apiVersion() + "/" + endpoint
 }
 
 // isTokenExpired checks if current authentication token has expired.
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewSession(t *testing.T) {
@@ -41,6 +42,60 @@ func TestBuildURI(t *testing.T) {
 	}
 }
 
+func TestSession_SetBaseURL(t *testing.T) {
+
+	sess := NewApp("test_api_key")
+	sess.SetBaseURL("http://localhost:8080/")
+
+	actual := sess.buildURI("foo")
+	expected := "http://localhost:8080/" + apiVersion + "/foo"
+
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
+
+func TestSession_SetAPIVersion(t *testing.T) {
+
+	sess := NewApp("test_api_key")
+	sess.SetBaseURL("http://localhost:8080/")
+	sess.SetAPIVersion("/v3/")
+
+	actual := sess.buildURI("foo")
+	expected := "http://localhost:8080/v3/foo"
+
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+
+	sess.SetAPIVersion("")
+
+	actual = sess.buildURI("foo")
+	expected = "http://localhost:8080/" + apiVersion + "/foo"
+
+	if actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
+
+func TestSession_SetHTTPClient(t *testing.T) {
+
+	sess := NewApp("test_api_key")
+
+	if sess.client() != http.DefaultClient {
+		t.Errorf("Actual: %v, expected: %v", sess.client(), http.DefaultClient)
+	}
+
+	c := &http.Client{
+		Timeout: time.Second,
+	}
+	sess.SetHTTPClient(c)
+
+	if sess.client() != c {
+		t.Errorf("Actual: %v, expected: %v", sess.client(), c)
+	}
+}
+
 func TestAuthResponseValidation_Success(t *testing.T) {
 
 	resp := &AuthResponse{
@@ -146,3 +201,129 @@ func TestApiKeyAuth(t *testing.T) {
 	app.host = ts.URL
 	app.HTTPCall("GET", "key-test", nil)
 }
+
+func TestNewSessionWithPAT(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/users/foo/apps/bar/inputs", func(w http.ResponseWriter, r *http.Request) {
+		actual := r.Header.Get("Authorization")
+		expected := "Key test_pat"
+		if expected != actual {
+			t.Errorf("Actual: %v, expected: %v", actual, expected)
+		}
+
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewSessionWithPAT("test_pat", "foo", "bar")
+	app.SetBaseURL(ts.URL)
+
+	_, err := app.GetAllInputs().Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+}
+
+func TestSession_SetMaxResponseBytes(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/large", func(w http.ResponseWriter, r *http.Request) {
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+
+	if _, err := app.HTTPCall(http.MethodGet, "inputs/large", nil); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	app.SetMaxResponseBytes(64)
+
+	if _, err := app.HTTPCall(http.MethodGet, "inputs/large", nil); err != ErrResponseTooLarge {
+		t.Errorf("Actual: %v, expected: %v", err, ErrResponseTooLarge)
+	}
+}
+
+func TestSession_Close(t *testing.T) {
+
+	serverReset()
+	mux.HandleFunc("/"+apiVersion+"/inputs/close", func(w http.ResponseWriter, r *http.Request) {
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+	app.SetHTTPClient(&http.Client{})
+
+	if _, err := app.HTTPCall(http.MethodGet, "inputs/close", nil); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if err := app.Close(); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if _, err := app.HTTPCall(http.MethodGet, "inputs/close", nil); err != ErrSessionClosed {
+		t.Errorf("Actual: %v, expected: %v", err, ErrSessionClosed)
+	}
+
+	if err := app.Close(); err != nil {
+		t.Errorf("Closing a closed session should have no errors, but got %v", err)
+	}
+}
+
+func TestSession_SetDefaultHeader_Connect(t *testing.T) {
+
+	serverReset()
+
+	var actual string
+	mux.HandleFunc("/"+apiVersion+"/token", func(w http.ResponseWriter, r *http.Request) {
+		actual = r.Header.Get("X-Gateway-Key")
+		printMock(t, w, "resp/ok_auth.json")
+	})
+
+	app := NewSession(mockClientID, mockClientSecret)
+	app.SetBaseURL(ts.URL)
+	app.SetDefaultHeader("X-Gateway-Key", "foo")
+
+	if err := app.Connect(); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if expected := "foo"; actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
+
+func TestSession_SetUserAgent(t *testing.T) {
+
+	serverReset()
+
+	var actual string
+	mux.HandleFunc("/"+apiVersion+"/inputs/ua", func(w http.ResponseWriter, r *http.Request) {
+		actual = r.Header.Get("User-Agent")
+		printMock(t, w, "resp/ok_inputs.json")
+	})
+
+	app := NewApp("test_api_key")
+	app.SetBaseURL(ts.URL)
+
+	if _, err := app.HTTPCall(http.MethodGet, "inputs/ua", nil); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if expected := "clarifai-client-go/" + Version; actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+
+	app.SetUserAgent("foo-service/1.0")
+
+	if _, err := app.HTTPCall(http.MethodGet, "inputs/ua", nil); err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if expected := "foo-service/1.0"; actual != expected {
+		t.Errorf("Actual: %v, expected: %v", actual, expected)
+	}
+}
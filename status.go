@@ -0,0 +1,99 @@
+package clarifai
+
+import "strconv"
+
+// StatusCode is a status code of Clarifai API.
+type StatusCode int
+
+// Common status codes of Clarifai API.
+const (
+	StatusSuccess                 StatusCode = 10000
+	StatusMixedSuccess            StatusCode = 10010
+	StatusFailure                 StatusCode = 10020
+	StatusTokenInvalid            StatusCode = 11001
+	StatusCredentialsInvalid      StatusCode = 11002
+	StatusBadRequestFormat        StatusCode = 11100
+	StatusDoesNotExist            StatusCode = 11101
+	StatusModelTrained            StatusCode = 21100
+	StatusModelTraining           StatusCode = 21101
+	StatusModelUntrained          StatusCode = 21102
+	StatusModelQueued             StatusCode = 21103
+	StatusModelNoPositives        StatusCode = 21202
+	StatusInputDownloadSuccess    StatusCode = 30000
+	StatusInputDownloadPending    StatusCode = 30001
+	StatusInputDownloadFailed     StatusCode = 30002
+	StatusInputDownloadInProgress StatusCode = 30003
+	StatusInputDuplicateURL       StatusCode = 30100
+	StatusInputInvalidArgument    StatusCode = 30104
+)
+
+var statusCodeNames = map[StatusCode]string{
+	StatusSuccess:                 "SUCCESS",
+	StatusMixedSuccess:            "MIXED_SUCCESS",
+	StatusFailure:                 "FAILURE",
+	StatusTokenInvalid:            "TOKEN_INVALID",
+	StatusCredentialsInvalid:      "CREDENTIALS_INVALID",
+	StatusBadRequestFormat:        "BAD_REQUEST_FORMAT",
+	StatusDoesNotExist:            "DOES_NOT_EXIST",
+	StatusModelTrained:            "MODEL_TRAINED",
+	StatusModelTraining:           "MODEL_TRAINING",
+	StatusModelUntrained:          "MODEL_UNTRAINED",
+	StatusModelQueued:             "MODEL_QUEUED",
+	StatusModelNoPositives:        "MODEL_NO_POSITIVES",
+	StatusInputDownloadSuccess:    "INPUT_DOWNLOAD_SUCCESS",
+	StatusInputDownloadPending:    "INPUT_DOWNLOAD_PENDING",
+	StatusInputDownloadFailed:     "INPUT_DOWNLOAD_FAILED",
+	StatusInputDownloadInProgress: "INPUT_DOWNLOAD_IN_PROGRESS",
+	StatusInputDuplicateURL:       "INPUT_DUPLICATE_URL",
+	StatusInputInvalidArgument:    "INPUT_INVALID_ARGUMENT",
+}
+
+// String returns a name of a known status code, or the number otherwise.
+func (c StatusCode) String() string {
+	if name, ok := statusCodeNames[c]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(c))
+}
+
+// IsTerminal reports whether the status is final, i.e. it is not an input being downloaded or a model being trained.
+func (c StatusCode) IsTerminal() bool {
+	switch c {
+	case StatusInputDownloadPending, StatusInputDownloadInProgress, StatusModelTraining, StatusModelQueued:
+		return false
+	}
+
+	return true
+}
+
+// ServiceStatus is a universal status info object.
+type ServiceStatus struct {
+	Code        StatusCode `json:"code"`
+	Description string     `json:"description"`
+	Details     string     `json:"details,omitempty"` // optional
+}
+
+// IsSuccess reports whether the status is a success, including a successfully downloaded input.
+func (s *ServiceStatus) IsSuccess() bool {
+	return s != nil && (s.Code == StatusSuccess || s.Code == StatusInputDownloadSuccess)
+}
+
+// IsPending reports whether an input is still being downloaded by the API.
+func (s *ServiceStatus) IsPending() bool {
+	return s != nil && (s.Code == StatusInputDownloadPending || s.Code == StatusInputDownloadInProgress)
+}
+
+// Error returns an *APIError for a non-successful status and nil otherwise.
+func (s *ServiceStatus) Error() error {
+
+	if s == nil || s.IsSuccess() {
+		return nil
+	}
+
+	return &APIError{
+		Code:        s.Code,
+		Description: s.Description,
+		Details:     s.Details,
+	}
+}
@@ -0,0 +1,92 @@
+package clarifai
+
+import "testing"
+
+func TestServiceStatus_IsSuccess(t *testing.T) {
+
+	var tests = []struct {
+		status   *ServiceStatus
+		expected bool
+	}{
+		{nil, false},
+		{&ServiceStatus{Code: StatusSuccess}, true},
+		{&ServiceStatus{Code: StatusInputDownloadSuccess}, true},
+		{&ServiceStatus{Code: StatusInputDownloadPending}, false},
+		{&ServiceStatus{Code: StatusInputDownloadFailed}, false},
+	}
+
+	for _, tt := range tests {
+		if actual := tt.status.IsSuccess(); actual != tt.expected {
+			t.Errorf("Status %+v | Actual: %v, expected: %v", tt.status, actual, tt.expected)
+		}
+	}
+}
+
+func TestServiceStatus_IsPending(t *testing.T) {
+
+	if !(&ServiceStatus{Code: StatusInputDownloadPending}).IsPending() {
+		t.Errorf("Actual: %v, expected: %v", false, true)
+	}
+
+	if (&ServiceStatus{Code: StatusInputDownloadFailed}).IsPending() {
+		t.Errorf("Actual: %v, expected: %v", true, false)
+	}
+}
+
+func TestServiceStatus_Error(t *testing.T) {
+
+	if err := (&ServiceStatus{Code: StatusSuccess}).Error(); err != nil {
+		t.Errorf("Actual: %v, expected: %v", err, nil)
+	}
+
+	err := (&ServiceStatus{Code: StatusInputDownloadFailed, Description: "Download failed"}).Error()
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Actual: %v, expected an *APIError", err)
+	}
+
+	if apiErr.Code != StatusInputDownloadFailed {
+		t.Errorf("Actual: %v, expected: %v", apiErr.Code, StatusInputDownloadFailed)
+	}
+
+	expected := "Clarifai API error 30002 (Download failed)"
+	if err.Error() != expected {
+		t.Errorf("Actual: %v, expected: %v", err.Error(), expected)
+	}
+}
+
+func TestStatusCode_String(t *testing.T) {
+
+	if actual := StatusInputDownloadPending.String(); actual != "INPUT_DOWNLOAD_PENDING" {
+		t.Errorf("Actual: %v, expected: %v", actual, "INPUT_DOWNLOAD_PENDING")
+	}
+
+	if actual := StatusCode(12345).String(); actual != "12345" {
+		t.Errorf("Actual: %v, expected: %v", actual, "12345")
+	}
+}
+
+func TestStatusCode_IsTerminal(t *testing.T) {
+
+	var tests = []struct {
+		code     StatusCode
+		expected bool
+	}{
+		{StatusSuccess, true},
+		{StatusInputDownloadSuccess, true},
+		{StatusInputDownloadFailed, true},
+		{StatusInputDownloadPending, false},
+		{StatusInputDownloadInProgress, false},
+		{StatusModelTrained, true},
+		{StatusModelTraining, false},
+		{StatusModelQueued, false},
+		{StatusModelNoPositives, true},
+	}
+
+	for _, tt := range tests {
+		if actual := tt.code.IsTerminal(); actual != tt.expected {
+			t.Errorf("Code %v | Actual: %v, expected: %v", tt.code, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package clarifai
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// streamedPlaceholder marks a place of streamed base64 data in an encoded input.
+const streamedPlaceholder = "__clarifai_streamed_base64__"
+
+// NewImageFromFileStreamed instantiates a new image from a local file, which is read and base64-encoded
+// only while a request is being sent. Such images can only be added by AddInputsStreamed.
+func NewImageFromFileStreamed(path string) (*Image, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return &Image{}, fmt.Errorf("Unable to open image file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	// 512 bytes are enough to detect a content type.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return &Image{}, fmt.Errorf("Unable to read image file %s: %w", path, err)
+	}
+
+	if err = validateLocalFile(head[:n]); err != nil {
+		return &Image{}, err
+	}
+
+	return &Image{
+		Properties: &ImageProperties{},
+		file:       path,
+	}, nil
+}
+
+// AddInputsStreamed adds inputs like AddInputs, but the request body is encoded while it is being sent,
+// so images created by NewImageFromFileStreamed are never held in memory as a whole.
+func (s *Session) AddInputsStreamed(p *Inputs) *Request {
+
+	r := NewRequest(s, http.MethodPost, "inputs")
+	r.SetPayload(&streamedInputs{Inputs: p.Inputs})
+	r.err = validateInputs(p.Inputs, true)
+
+	return r
+}
+
+// streamer is implemented by payloads encoding themselves into a request body on the fly.
+type streamer interface {
+	stream(w io.Writer) error
+}
+
+// streamedInputs is an add-inputs payload with streamed images.
+type streamedInputs struct {
+	Inputs []*Input `json:"inputs"`
+}
+
+// stream writes inputs as JSON, base64-encoding streamed images from their files.
+func (p *streamedInputs) stream(w io.Writer) error {
+
+	if _, err := io.WriteString(w, `{"inputs":[`); err != nil {
+		return err
+	}
+
+	for n, in := range p.Inputs {
+		if n > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+
+		if err := streamInput(w, in); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]}")
+	return err
+}
+
+// streamInput writes an input as JSON. Base64 data of a streamed image is written in place of a placeholder.
+func streamInput(w io.Writer, in *Input) error {
+
+	if in.Data == nil || in.Data.file == "" {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	}
+
+	data := *in.Data
+	props := *in.Data.Properties
+	props.Base64 = streamedPlaceholder
+	data.Properties = &props
+
+	c := *in
+	c.Data = &data
+
+	b, err := json.Marshal(&c)
+	if err != nil {
+		return err
+	}
+	parts := bytes.SplitN(b, []byte(streamedPlaceholder), 2)
+
+	if _, err = w.Write(parts[0]); err != nil {
+		return err
+	}
+
+	file, err := os.Open(in.Data.file)
+	if err != nil {
+		return fmt.Errorf("Unable to open image file %s: %w", in.Data.file, err)
+	}
+	defer file.Close()
+
+	enc := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err = io.Copy(enc, file); err != nil {
+		return fmt.Errorf("Unable to read image file %s: %w", in.Data.file, err)
+	}
+	if err = enc.Close(); err != nil {
+		return err
+	}
+
+	_, err = w.Write(parts[1])
+	return err
+}
+
+// streamBody is a request body produced by a streamer. Encoding starts on the first read.
+type streamBody struct {
+	st   streamer
+	pr   *io.PipeReader
+	once sync.Once
+}
+
+func newStreamBody(st streamer) *streamBody {
+	return &streamBody{st: st}
+}
+
+func (b *streamBody) start() {
+	pr, pw := io.Pipe()
+	b.pr = pr
+
+	go func() {
+		pw.CloseWithError(b.st.stream(pw))
+	}()
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	b.once.Do(b.start)
+	return b.pr.Read(p)
+}
+
+func (b *streamBody) Close() error {
+	b.once.Do(b.start)
+	return b.pr.Close()
+}
@@ -0,0 +1,92 @@
+package clarifai
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewImageFromFileStreamed(t *testing.T) {
+
+	i, err := NewImageFromFileStreamed("mocks/test_image.jpg")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if i.file != "mocks/test_image.jpg" || i.Properties.Base64 != "" {
+		t.Errorf("Actual: %+v, expected an image streamed from %v", i, "mocks/test_image.jpg")
+	}
+
+	_, err = NewImageFromFileStreamed("mocks/unsupported_mime_type_gif.gif")
+	if err != ErrUnsupportedMimeType {
+		t.Errorf("Actual: %v, expected: %v", err, ErrUnsupportedMimeType)
+	}
+}
+
+func TestSession_AddInputsStreamed(t *testing.T) {
+
+	serverReset()
+
+	var body []byte
+	var length int64
+	mux.HandleFunc("/"+apiVersion+"/inputs", func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		length = r.ContentLength
+		printMock(t, w, "resp/ok_10000_added_1_local_image.json")
+	})
+	sess.tokenExpiration = time.Now().Second() + 3600 // imitate existence of non-expired token
+
+	img, err := NewImageFromFileStreamed("mocks/test_image.jpg")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	img.AllowDuplicates()
+
+	i := InitInputs()
+	_ = i.AddInput(img, "foo")
+	_ = i.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "bar")
+
+	_, err = sess.AddInputsStreamed(i).Do()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if length != -1 {
+		t.Errorf("Actual: %v, expected a chunked body", length)
+	}
+
+	var actual Inputs
+	if err = json.Unmarshal(body, &actual); err != nil {
+		t.Fatalf("Should have no errors, but got %v (body %s)", err, body)
+	}
+
+	if len(actual.Inputs) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(actual.Inputs), 2)
+	}
+
+	p := actual.Inputs[0].Data.Properties
+	if actual.Inputs[0].ID != "foo" || p.Base64 != TestImageBase64 || !p.AllowDuplicateURL {
+		t.Errorf("Actual: %+v, expected a base64 image with ID %v", p, "foo")
+	}
+
+	if actual.Inputs[1].Data.Properties.URL != "https://samples.clarifai.com/metro-north.jpg" {
+		t.Errorf("Actual: %v, expected: %v", actual.Inputs[1].Data.Properties.URL, "https://samples.clarifai.com/metro-north.jpg")
+	}
+}
+
+func TestSession_AddInputs_StreamedImage(t *testing.T) {
+
+	img, err := NewImageFromFileStreamed("mocks/test_image.jpg")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	i := InitInputs()
+	_ = i.AddInput(img, "foo")
+
+	if _, err = sess.AddInputs(i).Do(); err != ErrStreamedImage {
+		t.Errorf("Actual: %v, expected: %v", err, ErrStreamedImage)
+	}
+}
@@ -0,0 +1,23 @@
+package clarifai
+
+// Text is a text input for text models ("input" -> "data" -> "text").
+type Text struct {
+	Raw string `json:"raw,omitempty"`
+	URL string `json:"url,omitempty"` // URL of a plain text file.
+}
+
+// NewTextFromString instantiates a new text from a raw string.
+func NewTextFromString(raw string) *Text {
+
+	return &Text{
+		Raw: raw,
+	}
+}
+
+// NewTextFromURL instantiates a new text based on URL of a plain text file.
+func NewTextFromURL(url string) *Text {
+
+	return &Text{
+		URL: url,
+	}
+}
@@ -0,0 +1,60 @@
+package clarifai
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInputs_AddText(t *testing.T) {
+
+	i := InitInputs()
+
+	err := i.AddText(NewTextFromString("What a great day!"), "text-1")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"text":{"raw":"What a great day!"}},"id":"text-1"}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestSession_Predict_Text(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/sentiment-analysis/outputs", "resp/ok_10000_predict_text.json")
+
+	i := InitInputs()
+	i.SetModel("sentiment-analysis")
+	_ = i.AddText(NewTextFromURL("https://samples.clarifai.com/negative_sentence_1.txt"), "")
+
+	resp, err := sess.Predict(i).UnmarshalPredict()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	o := resp.Outputs[0]
+	if o.Input.Data.Text.Raw != "What a great day!" {
+		t.Errorf("Actual: %v, expected: %v", o.Input.Data.Text.Raw, "What a great day!")
+	}
+
+	if o.Data.Concepts[0].ID != "positive" || o.Data.Concepts[0].Value != 0.97 {
+		t.Errorf("Actual: %+v, expected concept %v", o.Data.Concepts[0], "positive")
+	}
+}
+
+func TestSession_Predict_EmptyText(t *testing.T) {
+
+	i := InitInputs()
+	_ = i.AddText(&Text{}, "")
+
+	if _, err := sess.Predict(i).Do(); err != ErrEmptyImageData {
+		t.Errorf("Actual: %v, expected: %v", err, ErrEmptyImageData)
+	}
+}
@@ -0,0 +1,40 @@
+package clarifai
+
+import "encoding/base64"
+
+// Video is a video input ("input" -> "data" -> "video").
+type Video struct {
+	URL    string `json:"url,omitempty"`
+	Base64 string `json:"base64,omitempty"`
+}
+
+// Frame is a prediction for a single frame of a video input.
+type Frame struct {
+	FrameInfo *FrameInfo `json:"frame_info,omitempty"`
+	Data      *FrameData `json:"data,omitempty"`
+}
+
+type FrameInfo struct {
+	Index int `json:"index"`
+	Time  int `json:"time"` // Frame position in milliseconds.
+}
+
+type FrameData struct {
+	Concepts []*OutputConcept `json:"concepts,omitempty"`
+}
+
+// NewVideoFromURL instantiates a new video based on URL.
+func NewVideoFromURL(url string) *Video {
+
+	return &Video{
+		URL: url,
+	}
+}
+
+// NewVideoFromBytes instantiates a new video from raw video bytes.
+func NewVideoFromBytes(b []byte) *Video {
+
+	return &Video{
+		Base64: base64.StdEncoding.EncodeToString(b),
+	}
+}
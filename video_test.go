@@ -0,0 +1,71 @@
+package clarifai
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInputs_AddVideo(t *testing.T) {
+
+	data := InitInputs()
+	err := data.AddVideo(NewVideoFromURL("https://samples.clarifai.com/beer.mp4"), "beer-1")
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	actual, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+	expected := `{"inputs":[{"data":{"video":{"url":"https://samples.clarifai.com/beer.mp4"}},"id":"beer-1"}]}`
+
+	if string(actual) != expected {
+		t.Errorf("Actual: %v, expected: %v", string(actual), expected)
+	}
+}
+
+func TestNewVideoFromBytes(t *testing.T) {
+
+	v := NewVideoFromBytes([]byte("foo"))
+
+	if v.Base64 != "Zm9v" {
+		t.Errorf("Actual: %v, expected: %v", v.Base64, "Zm9v")
+	}
+}
+
+func TestRequest_UnmarshalPredict_Frames(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "models/"+PublicModelGeneral+"/outputs", "resp/ok_10000_predict_video.json")
+
+	data := InitInputs()
+	_ = data.AddVideo(NewVideoFromURL("https://samples.clarifai.com/beer.mp4"), "")
+
+	resp, err := sess.Predict(data).UnmarshalPredict()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	frames := resp.Outputs[0].Data.Frames
+	if len(frames) != 3 {
+		t.Fatalf("Actual: %v, expected: %v", len(frames), 3)
+	}
+
+	for j, f := range frames {
+		if f.FrameInfo.Index != j || f.FrameInfo.Time != j*1000 {
+			t.Errorf("Frame %d | Actual: %+v, expected index %d at %d ms", j, f.FrameInfo, j, j*1000)
+		}
+	}
+
+	expected := &OutputConcept{
+		ID:    "ai_zJx6RbxW",
+		Name:  "drink",
+		Value: 0.9935212,
+		AppID: String("main"),
+	}
+	CompareStructs(t, expected, frames[0].Data.Concepts[0])
+
+	if resp.Outputs[0].Input.Data.Video.URL != "https://samples.clarifai.com/beer.mp4" {
+		t.Errorf("Actual: %v, expected: %v", resp.Outputs[0].Input.Data.Video.URL, "https://samples.clarifai.com/beer.mp4")
+	}
+}
@@ -0,0 +1,65 @@
+package clarifai
+
+import "net/http"
+
+// Workflow is a set of models predicted together in a single call.
+type Workflow struct {
+	ID        string `json:"id"`
+	AppID     string `json:"app_id,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// WorkflowResult holds outputs of all workflow models for a single input.
+type WorkflowResult struct {
+	Status  *ServiceStatus `json:"status,omitempty"`
+	Input   *Input         `json:"input,omitempty"`
+	Outputs []*Output      `json:"outputs,omitempty"`
+}
+
+// WorkflowResponse is a response of a workflow predict call.
+type WorkflowResponse struct {
+	Status   *ServiceStatus    `json:"status,omitempty"`
+	Workflow *Workflow         `json:"workflow,omitempty"`
+	Results  []*WorkflowResult `json:"results,omitempty"`
+}
+
+// PredictWorkflow fetches predictions of all models of a workflow for provided inputs.
+func (s *Session) PredictWorkflow(workflowID string, i *Inputs) *Request {
+
+	r := NewRequest(s, http.MethodPost, "workflows/"+workflowID+"/results")
+	r.SetPayload(i)
+	r.err = validateInputs(i.Inputs, false)
+
+	return r
+}
+
+// UnmarshalWorkflow sends a workflow predict request and returns its parsed response.
+// A non-successful status of the response is returned as an *APIError along with the response.
+func (r *Request) UnmarshalWorkflow() (*WorkflowResponse, error) {
+
+	resp, err := r.Do()
+	if resp == nil {
+		return nil, err
+	}
+
+	p := &WorkflowResponse{
+		Status:   resp.Status,
+		Workflow: resp.Workflow,
+		Results:  resp.Results,
+	}
+
+	return p, err
+}
+
+// OutputsByModel maps outputs of a workflow result to IDs of the models that produced them.
+func (r *WorkflowResult) OutputsByModel() map[string]*Output {
+
+	m := make(map[string]*Output, len(r.Outputs))
+	for _, o := range r.Outputs {
+		if o.Model != nil && o.Model.ID != nil {
+			m[*o.Model.ID] = o
+		}
+	}
+
+	return m
+}
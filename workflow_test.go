@@ -0,0 +1,40 @@
+package clarifai
+
+import (
+	"testing"
+)
+
+func TestSession_PredictWorkflow(t *testing.T) {
+
+	serverReset()
+	mockRoute(t, "workflows/my-workflow/results", "resp/ok_10000_predict_workflow.json")
+
+	data := InitInputs()
+	_ = data.AddInput(NewImageFromURL("https://samples.clarifai.com/metro-north.jpg"), "")
+
+	resp, err := sess.PredictWorkflow("my-workflow", data).UnmarshalWorkflow()
+	if err != nil {
+		t.Fatalf("Should have no errors, but got %v", err)
+	}
+
+	if resp.Workflow.ID != "my-workflow" {
+		t.Errorf("Actual: %v, expected: %v", resp.Workflow.ID, "my-workflow")
+	}
+
+	if len(resp.Results) != 1 {
+		t.Fatalf("Actual: %v, expected: %v", len(resp.Results), 1)
+	}
+
+	outputs := resp.Results[0].OutputsByModel()
+	if len(outputs) != 2 {
+		t.Fatalf("Actual: %v, expected: %v", len(outputs), 2)
+	}
+
+	if outputs[PublicModelGeneral].Data.Concepts[0].Name != "train" {
+		t.Errorf("Actual: %v, expected: %v", outputs[PublicModelGeneral].Data.Concepts[0].Name, "train")
+	}
+
+	if outputs[PublicModelFood].Data.Concepts[0].Name != "coffee" {
+		t.Errorf("Actual: %v, expected: %v", outputs[PublicModelFood].Data.Concepts[0].Name, "coffee")
+	}
+}